@@ -30,9 +30,11 @@ variable "service_labels" {
     "autoscalingplans",
     "backup",
     "batch",
+    "bedrock",
     "braket",
     "budgets",
     "chime",
+    "cleanrooms",
     "cloud9",
     "cloudcontrolapi",
     "clouddirectory",
@@ -110,6 +112,7 @@ variable "service_labels" {
     "identitystore",
     "imagebuilder",
     "inspector",
+    "inspector2",
     "iot",
     "iotanalytics",
     "iotevents",
@@ -160,6 +163,7 @@ variable "service_labels" {
     "pinpoint",
     "pinpointemail",
     "pinpointsmsvoice",
+    "pipes",
     "polly",
     "pricing",
     "qldb",
@@ -180,6 +184,7 @@ variable "service_labels" {
     "s3outposts",
     "sagemaker",
     "savingsplans",
+    "scheduler",
     "schemas",
     "secretsmanager",
     "securityhub",
@@ -210,6 +215,7 @@ variable "service_labels" {
     "timestreamwrite",
     "transfer",
     "translate",
+    "verifiedpermissions",
     "waf",
     "wafv2",
     "workdocs",
@@ -168,6 +168,28 @@ func TestAccAcctestProvider_DefaultAndIgnoreTags_emptyBlocks(t *testing.T) {
 	})
 }
 
+func TestAccAcctestProvider_DefaultTags_excludeResourceTypes(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { PreCheck(t) },
+		ErrorCheck:   ErrorCheck(t),
+		Providers:    Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultTagsExcludeResourceTypesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.test", "tags_all.%", "1"),
+					resource.TestCheckNoResourceAttr("aws_sqs_queue.test", "tags_all.providerkey1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAcctestProvider_endpoints(t *testing.T) {
 	var providers []*schema.Provider
 	var endpoints strings.Builder
@@ -1048,6 +1070,40 @@ provider "aws" {
 `)
 }
 
+func testAccDefaultTagsExcludeResourceTypesConfig(rName string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      providerkey1 = "providervalue1"
+    }
+
+    exclude_resource_types = ["aws_sqs_queue"]
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccDefaultAndIgnoreTagsEmptyConfigurationBlockConfig() string {
 	//lintignore:AT004
 	return ConfigCompose(
@@ -37,9 +37,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscalingplans"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/bedrock"
 	"github.com/aws/aws-sdk-go/service/braket"
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
 	"github.com/aws/aws-sdk-go/service/cloud9"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/clouddirectory"
@@ -128,6 +130,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/inspector2"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/aws/aws-sdk-go/service/iot1clickdevicesservice"
 	"github.com/aws/aws-sdk-go/service/iot1clickprojects"
@@ -206,6 +209,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/aws/aws-sdk-go/service/pinpointemail"
 	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/pipes"
 	"github.com/aws/aws-sdk-go/service/polly"
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
@@ -235,6 +239,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemakerfeaturestoreruntime"
 	"github.com/aws/aws-sdk-go/service/sagemakerruntime"
 	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/aws/aws-sdk-go/service/scheduler"
 	"github.com/aws/aws-sdk-go/service/schemas"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/securityhub"
@@ -270,6 +275,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/aws/aws-sdk-go/service/wafv2"
@@ -317,9 +323,11 @@ const (
 	AutoScalingPlans              = "autoscalingplans"
 	Backup                        = "backup"
 	Batch                         = "batch"
+	Bedrock                       = "bedrock"
 	Braket                        = "braket"
 	Budgets                       = "budgets"
 	Chime                         = "chime"
+	CleanRooms                    = "cleanrooms"
 	Cloud9                        = "cloud9"
 	CloudControl                  = "cloudcontrol"
 	CloudDirectory                = "clouddirectory"
@@ -411,6 +419,7 @@ const (
 	IdentityStore                 = "identitystore"
 	ImageBuilder                  = "imagebuilder"
 	Inspector                     = "inspector"
+	Inspector2                    = "inspector2"
 	IoT                           = "iot"
 	IoT1ClickDevices              = "iot1clickdevices"
 	IoT1ClickProjects             = "iot1clickprojects"
@@ -489,6 +498,7 @@ const (
 	Pinpoint                      = "pinpoint"
 	PinpointEmail                 = "pinpointemail"
 	PinpointSMSVoice              = "pinpointsmsvoice"
+	Pipes                         = "pipes"
 	Polly                         = "polly"
 	Pricing                       = "pricing"
 	Proton                        = "proton"
@@ -517,6 +527,7 @@ const (
 	SageMakerFeatureStoreRuntime  = "sagemakerfeaturestoreruntime"
 	SageMakerRuntime              = "sagemakerruntime"
 	SavingsPlans                  = "savingsplans"
+	Scheduler                     = "scheduler"
 	Schemas                       = "schemas"
 	SecretsManager                = "secretsmanager"
 	SecurityHub                   = "securityhub"
@@ -552,6 +563,7 @@ const (
 	TranscribeStreaming           = "transcribestreaming"
 	Transfer                      = "transfer"
 	Translate                     = "translate"
+	VerifiedPermissions           = "verifiedpermissions"
 	WAF                           = "waf"
 	WAFRegional                   = "wafregional"
 	WAFV2                         = "wafv2"
@@ -607,9 +619,11 @@ func init() {
 	serviceData[AutoScalingPlans] = &ServiceDatum{AWSClientName: "AutoScalingPlans", AWSServiceName: autoscalingplans.ServiceName, AWSEndpointsID: autoscalingplans.EndpointsID, AWSServiceID: autoscalingplans.ServiceID, ProviderNameUpper: "AutoScalingPlans", HCLKeys: []string{"autoscalingplans"}}
 	serviceData[Backup] = &ServiceDatum{AWSClientName: "Backup", AWSServiceName: backup.ServiceName, AWSEndpointsID: backup.EndpointsID, AWSServiceID: backup.ServiceID, ProviderNameUpper: "Backup", HCLKeys: []string{"backup"}}
 	serviceData[Batch] = &ServiceDatum{AWSClientName: "Batch", AWSServiceName: batch.ServiceName, AWSEndpointsID: batch.EndpointsID, AWSServiceID: batch.ServiceID, ProviderNameUpper: "Batch", HCLKeys: []string{"batch"}}
+	serviceData[Bedrock] = &ServiceDatum{AWSClientName: "Bedrock", AWSServiceName: bedrock.ServiceName, AWSEndpointsID: bedrock.EndpointsID, AWSServiceID: bedrock.ServiceID, ProviderNameUpper: "Bedrock", HCLKeys: []string{"bedrock"}}
 	serviceData[Braket] = &ServiceDatum{AWSClientName: "Braket", AWSServiceName: braket.ServiceName, AWSEndpointsID: braket.EndpointsID, AWSServiceID: braket.ServiceID, ProviderNameUpper: "Braket", HCLKeys: []string{"braket"}}
 	serviceData[Budgets] = &ServiceDatum{AWSClientName: "Budgets", AWSServiceName: budgets.ServiceName, AWSEndpointsID: budgets.EndpointsID, AWSServiceID: budgets.ServiceID, ProviderNameUpper: "Budgets", HCLKeys: []string{"budgets"}}
 	serviceData[Chime] = &ServiceDatum{AWSClientName: "Chime", AWSServiceName: chime.ServiceName, AWSEndpointsID: chime.EndpointsID, AWSServiceID: chime.ServiceID, ProviderNameUpper: "Chime", HCLKeys: []string{"chime"}}
+	serviceData[CleanRooms] = &ServiceDatum{AWSClientName: "CleanRooms", AWSServiceName: cleanrooms.ServiceName, AWSEndpointsID: cleanrooms.EndpointsID, AWSServiceID: cleanrooms.ServiceID, ProviderNameUpper: "CleanRooms", HCLKeys: []string{"cleanrooms"}}
 	serviceData[Cloud9] = &ServiceDatum{AWSClientName: "Cloud9", AWSServiceName: cloud9.ServiceName, AWSEndpointsID: cloud9.EndpointsID, AWSServiceID: cloud9.ServiceID, ProviderNameUpper: "Cloud9", HCLKeys: []string{"cloud9"}}
 	serviceData[CloudControl] = &ServiceDatum{AWSClientName: "CloudControlApi", AWSServiceName: cloudcontrolapi.ServiceName, AWSEndpointsID: cloudcontrolapi.EndpointsID, AWSServiceID: cloudcontrolapi.ServiceID, ProviderNameUpper: "CloudControl", HCLKeys: []string{"cloudcontrolapi", "cloudcontrol"}}
 	serviceData[CloudDirectory] = &ServiceDatum{AWSClientName: "CloudDirectory", AWSServiceName: clouddirectory.ServiceName, AWSEndpointsID: clouddirectory.EndpointsID, AWSServiceID: clouddirectory.ServiceID, ProviderNameUpper: "CloudDirectory", HCLKeys: []string{"clouddirectory"}}
@@ -699,6 +713,7 @@ func init() {
 	serviceData[IdentityStore] = &ServiceDatum{AWSClientName: "IdentityStore", AWSServiceName: identitystore.ServiceName, AWSEndpointsID: identitystore.EndpointsID, AWSServiceID: identitystore.ServiceID, ProviderNameUpper: "IdentityStore", HCLKeys: []string{"identitystore"}}
 	serviceData[ImageBuilder] = &ServiceDatum{AWSClientName: "ImageBuilder", AWSServiceName: imagebuilder.ServiceName, AWSEndpointsID: imagebuilder.EndpointsID, AWSServiceID: imagebuilder.ServiceID, ProviderNameUpper: "ImageBuilder", HCLKeys: []string{"imagebuilder"}}
 	serviceData[Inspector] = &ServiceDatum{AWSClientName: "Inspector", AWSServiceName: inspector.ServiceName, AWSEndpointsID: inspector.EndpointsID, AWSServiceID: inspector.ServiceID, ProviderNameUpper: "Inspector", HCLKeys: []string{"inspector"}}
+	serviceData[Inspector2] = &ServiceDatum{AWSClientName: "Inspector2", AWSServiceName: inspector2.ServiceName, AWSEndpointsID: inspector2.EndpointsID, AWSServiceID: inspector2.ServiceID, ProviderNameUpper: "Inspector2", HCLKeys: []string{"inspector2"}}
 	serviceData[IoT] = &ServiceDatum{AWSClientName: "IoT", AWSServiceName: iot.ServiceName, AWSEndpointsID: iot.EndpointsID, AWSServiceID: iot.ServiceID, ProviderNameUpper: "IoT", HCLKeys: []string{"iot"}}
 	serviceData[IoT1ClickDevices] = &ServiceDatum{AWSClientName: "IoT1ClickDevicesService", AWSServiceName: iot1clickdevicesservice.ServiceName, AWSEndpointsID: iot1clickdevicesservice.EndpointsID, AWSServiceID: iot1clickdevicesservice.ServiceID, ProviderNameUpper: "IoT1ClickDevices", HCLKeys: []string{"iot1clickdevices", "iot1clickdevicesservice"}}
 	serviceData[IoT1ClickProjects] = &ServiceDatum{AWSClientName: "IoT1ClickProjects", AWSServiceName: iot1clickprojects.ServiceName, AWSEndpointsID: iot1clickprojects.EndpointsID, AWSServiceID: iot1clickprojects.ServiceID, ProviderNameUpper: "IoT1ClickProjects", HCLKeys: []string{"iot1clickprojects"}}
@@ -776,6 +791,7 @@ func init() {
 	serviceData[Pinpoint] = &ServiceDatum{AWSClientName: "Pinpoint", AWSServiceName: pinpoint.ServiceName, AWSEndpointsID: pinpoint.EndpointsID, AWSServiceID: pinpoint.ServiceID, ProviderNameUpper: "Pinpoint", HCLKeys: []string{"pinpoint"}}
 	serviceData[PinpointEmail] = &ServiceDatum{AWSClientName: "PinpointEmail", AWSServiceName: pinpointemail.ServiceName, AWSEndpointsID: pinpointemail.EndpointsID, AWSServiceID: pinpointemail.ServiceID, ProviderNameUpper: "PinpointEmail", HCLKeys: []string{"pinpointemail"}}
 	serviceData[PinpointSMSVoice] = &ServiceDatum{AWSClientName: "PinpointSMSVoice", AWSServiceName: pinpointsmsvoice.ServiceName, AWSEndpointsID: pinpointsmsvoice.EndpointsID, AWSServiceID: pinpointsmsvoice.ServiceID, ProviderNameUpper: "PinpointSMSVoice", HCLKeys: []string{"pinpointsmsvoice"}}
+	serviceData[Pipes] = &ServiceDatum{AWSClientName: "Pipes", AWSServiceName: pipes.ServiceName, AWSEndpointsID: pipes.EndpointsID, AWSServiceID: pipes.ServiceID, ProviderNameUpper: "Pipes", HCLKeys: []string{"pipes"}}
 	serviceData[Polly] = &ServiceDatum{AWSClientName: "Polly", AWSServiceName: polly.ServiceName, AWSEndpointsID: polly.EndpointsID, AWSServiceID: polly.ServiceID, ProviderNameUpper: "Polly", HCLKeys: []string{"polly"}}
 	serviceData[Pricing] = &ServiceDatum{AWSClientName: "Pricing", AWSServiceName: pricing.ServiceName, AWSEndpointsID: pricing.EndpointsID, AWSServiceID: pricing.ServiceID, ProviderNameUpper: "Pricing", HCLKeys: []string{"pricing"}}
 	serviceData[Proton] = &ServiceDatum{AWSClientName: "Proton", AWSServiceName: proton.ServiceName, AWSEndpointsID: proton.EndpointsID, AWSServiceID: proton.ServiceID, ProviderNameUpper: "Proton", HCLKeys: []string{"proton"}}
@@ -804,6 +820,7 @@ func init() {
 	serviceData[SageMakerFeatureStoreRuntime] = &ServiceDatum{AWSClientName: "SageMakerFeatureStoreRuntime", AWSServiceName: sagemakerfeaturestoreruntime.ServiceName, AWSEndpointsID: sagemakerfeaturestoreruntime.EndpointsID, AWSServiceID: sagemakerfeaturestoreruntime.ServiceID, ProviderNameUpper: "SageMakerFeatureStoreRuntime", HCLKeys: []string{"sagemakerfeaturestoreruntime"}}
 	serviceData[SageMakerRuntime] = &ServiceDatum{AWSClientName: "SageMakerRuntime", AWSServiceName: sagemakerruntime.ServiceName, AWSEndpointsID: sagemakerruntime.EndpointsID, AWSServiceID: sagemakerruntime.ServiceID, ProviderNameUpper: "SageMakerRuntime", HCLKeys: []string{"sagemakerruntime"}}
 	serviceData[SavingsPlans] = &ServiceDatum{AWSClientName: "SavingsPlans", AWSServiceName: savingsplans.ServiceName, AWSEndpointsID: savingsplans.EndpointsID, AWSServiceID: savingsplans.ServiceID, ProviderNameUpper: "SavingsPlans", HCLKeys: []string{"savingsplans"}}
+	serviceData[Scheduler] = &ServiceDatum{AWSClientName: "Scheduler", AWSServiceName: scheduler.ServiceName, AWSEndpointsID: scheduler.EndpointsID, AWSServiceID: scheduler.ServiceID, ProviderNameUpper: "Scheduler", HCLKeys: []string{"scheduler"}}
 	serviceData[Schemas] = &ServiceDatum{AWSClientName: "Schemas", AWSServiceName: schemas.ServiceName, AWSEndpointsID: schemas.EndpointsID, AWSServiceID: schemas.ServiceID, ProviderNameUpper: "Schemas", HCLKeys: []string{"schemas"}}
 	serviceData[SecretsManager] = &ServiceDatum{AWSClientName: "SecretsManager", AWSServiceName: secretsmanager.ServiceName, AWSEndpointsID: secretsmanager.EndpointsID, AWSServiceID: secretsmanager.ServiceID, ProviderNameUpper: "SecretsManager", HCLKeys: []string{"secretsmanager"}}
 	serviceData[SecurityHub] = &ServiceDatum{AWSClientName: "SecurityHub", AWSServiceName: securityhub.ServiceName, AWSEndpointsID: securityhub.EndpointsID, AWSServiceID: securityhub.ServiceID, ProviderNameUpper: "SecurityHub", HCLKeys: []string{"securityhub"}}
@@ -839,6 +856,7 @@ func init() {
 	serviceData[TranscribeStreaming] = &ServiceDatum{AWSClientName: "TranscribeStreamingService", AWSServiceName: transcribestreamingservice.ServiceName, AWSEndpointsID: transcribestreamingservice.EndpointsID, AWSServiceID: transcribestreamingservice.ServiceID, ProviderNameUpper: "TranscribeStreaming", HCLKeys: []string{"transcribestreaming", "transcribestreamingservice"}}
 	serviceData[Transfer] = &ServiceDatum{AWSClientName: "Transfer", AWSServiceName: transfer.ServiceName, AWSEndpointsID: transfer.EndpointsID, AWSServiceID: transfer.ServiceID, ProviderNameUpper: "Transfer", HCLKeys: []string{"transfer"}}
 	serviceData[Translate] = &ServiceDatum{AWSClientName: "Translate", AWSServiceName: translate.ServiceName, AWSEndpointsID: translate.EndpointsID, AWSServiceID: translate.ServiceID, ProviderNameUpper: "Translate", HCLKeys: []string{"translate"}}
+	serviceData[VerifiedPermissions] = &ServiceDatum{AWSClientName: "VerifiedPermissions", AWSServiceName: verifiedpermissions.ServiceName, AWSEndpointsID: verifiedpermissions.EndpointsID, AWSServiceID: verifiedpermissions.ServiceID, ProviderNameUpper: "VerifiedPermissions", HCLKeys: []string{"verifiedpermissions"}}
 	serviceData[WAF] = &ServiceDatum{AWSClientName: "WAF", AWSServiceName: waf.ServiceName, AWSEndpointsID: waf.EndpointsID, AWSServiceID: waf.ServiceID, ProviderNameUpper: "WAF", HCLKeys: []string{"waf"}}
 	serviceData[WAFRegional] = &ServiceDatum{AWSClientName: "WAFRegional", AWSServiceName: wafregional.ServiceName, AWSEndpointsID: wafregional.EndpointsID, AWSServiceID: wafregional.ServiceID, ProviderNameUpper: "WAFRegional", HCLKeys: []string{"wafregional"}}
 	serviceData[WAFV2] = &ServiceDatum{AWSClientName: "WAFV2", AWSServiceName: wafv2.ServiceName, AWSEndpointsID: wafv2.EndpointsID, AWSServiceID: wafv2.ServiceID, ProviderNameUpper: "WAFV2", HCLKeys: []string{"wafv2"}}
@@ -919,9 +937,11 @@ type AWSClient struct {
 	AutoScalingPlansConn              *autoscalingplans.AutoScalingPlans
 	BackupConn                        *backup.Backup
 	BatchConn                         *batch.Batch
+	BedrockConn                       *bedrock.Bedrock
 	BraketConn                        *braket.Braket
 	BudgetsConn                       *budgets.Budgets
 	ChimeConn                         *chime.Chime
+	CleanRoomsConn                    *cleanrooms.CleanRooms
 	Cloud9Conn                        *cloud9.Cloud9
 	CloudControlConn                  *cloudcontrolapi.CloudControlApi
 	CloudDirectoryConn                *clouddirectory.CloudDirectory
@@ -1014,6 +1034,7 @@ type AWSClient struct {
 	IgnoreTagsConfig                  *tftags.IgnoreConfig
 	ImageBuilderConn                  *imagebuilder.Imagebuilder
 	InspectorConn                     *inspector.Inspector
+	Inspector2Conn                    *inspector2.Inspector2
 	IoT1ClickDevicesConn              *iot1clickdevicesservice.IoT1ClickDevicesService
 	IoT1ClickProjectsConn             *iot1clickprojects.IoT1ClickProjects
 	IoTAnalyticsConn                  *iotanalytics.IoTAnalytics
@@ -1093,6 +1114,7 @@ type AWSClient struct {
 	PinpointConn                      *pinpoint.Pinpoint
 	PinpointEmailConn                 *pinpointemail.PinpointEmail
 	PinpointSMSVoiceConn              *pinpointsmsvoice.PinpointSMSVoice
+	PipesConn                         *pipes.Pipes
 	PollyConn                         *polly.Polly
 	PricingConn                       *pricing.Pricing
 	ProtonConn                        *proton.Proton
@@ -1124,6 +1146,7 @@ type AWSClient struct {
 	SageMakerFeatureStoreRuntimeConn  *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime
 	SageMakerRuntimeConn              *sagemakerruntime.SageMakerRuntime
 	SavingsPlansConn                  *savingsplans.SavingsPlans
+	SchedulerConn                     *scheduler.Scheduler
 	SchemasConn                       *schemas.Schemas
 	SecretsManagerConn                *secretsmanager.SecretsManager
 	SecurityHubConn                   *securityhub.SecurityHub
@@ -1161,6 +1184,7 @@ type AWSClient struct {
 	TranscribeStreamingConn           *transcribestreamingservice.TranscribeStreamingService
 	TransferConn                      *transfer.Transfer
 	TranslateConn                     *translate.Translate
+	VerifiedPermissionsConn           *verifiedpermissions.VerifiedPermissions
 	WAFConn                           *waf.WAF
 	WAFRegionalConn                   *wafregional.WAFRegional
 	WAFV2Conn                         *wafv2.WAFV2
@@ -1275,9 +1299,11 @@ func (c *Config) Client() (interface{}, error) {
 		AutoScalingPlansConn:              autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScalingPlans])})),
 		BackupConn:                        backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Backup])})),
 		BatchConn:                         batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Batch])})),
+		BedrockConn:                       bedrock.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Bedrock])})),
 		BraketConn:                        braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Braket])})),
 		BudgetsConn:                       budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Budgets])})),
 		ChimeConn:                         chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Chime])})),
+		CleanRoomsConn:                    cleanrooms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CleanRooms])})),
 		Cloud9Conn:                        cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Cloud9])})),
 		CloudControlConn:                  cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudControl])})),
 		CloudDirectoryConn:                clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudDirectory])})),
@@ -1369,6 +1395,7 @@ func (c *Config) Client() (interface{}, error) {
 		IgnoreTagsConfig:                  c.IgnoreTagsConfig,
 		ImageBuilderConn:                  imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ImageBuilder])})),
 		InspectorConn:                     inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Inspector])})),
+		Inspector2Conn:                    inspector2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Inspector2])})),
 		IoT1ClickDevicesConn:              iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickDevices])})),
 		IoT1ClickProjectsConn:             iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickProjects])})),
 		IoTAnalyticsConn:                  iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTAnalytics])})),
@@ -1447,6 +1474,7 @@ func (c *Config) Client() (interface{}, error) {
 		PinpointConn:                      pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pinpoint])})),
 		PinpointEmailConn:                 pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointEmail])})),
 		PinpointSMSVoiceConn:              pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointSMSVoice])})),
+		PipesConn:                         pipes.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pipes])})),
 		PollyConn:                         polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Polly])})),
 		PricingConn:                       pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pricing])})),
 		ProtonConn:                        proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Proton])})),
@@ -1475,6 +1503,7 @@ func (c *Config) Client() (interface{}, error) {
 		SageMakerFeatureStoreRuntimeConn:  sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerFeatureStoreRuntime])})),
 		SageMakerRuntimeConn:              sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerRuntime])})),
 		SavingsPlansConn:                  savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SavingsPlans])})),
+		SchedulerConn:                     scheduler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Scheduler])})),
 		SchemasConn:                       schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Schemas])})),
 		SecretsManagerConn:                secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecretsManager])})),
 		SecurityHubConn:                   securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecurityHub])})),
@@ -1510,6 +1539,7 @@ func (c *Config) Client() (interface{}, error) {
 		TranscribeStreamingConn:           transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TranscribeStreaming])})),
 		TransferConn:                      transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transfer])})),
 		TranslateConn:                     translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Translate])})),
+		VerifiedPermissionsConn:           verifiedpermissions.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[VerifiedPermissions])})),
 		WAFConn:                           waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAF])})),
 		WAFRegionalConn:                   wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFRegional])})),
 		WAFV2Conn:                         wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFV2])})),
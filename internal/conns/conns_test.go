@@ -1,7 +1,13 @@
 package conns
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -114,6 +120,112 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+// TestConfigClient_webIdentityTokenFileRotation verifies that credentials
+// sourced from a web identity token file (e.g. EKS IRSA) re-read the file when
+// they are refreshed, so a token rotated during a long-running apply is used.
+func TestConfigClient_webIdentityTokenFileRotation(t *testing.T) {
+	var mu sync.Mutex
+	var tokens []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if r.PostForm.Get("Action") != "AssumeRoleWithWebIdentity" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		tokens = append(tokens, r.PostForm.Get("WebIdentityToken"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(awsbase.MockStsAssumeRoleWithWebIdentityValidResponseBody))
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("token1"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	setenv(t, map[string]string{
+		"AWS_ACCESS_KEY_ID":           "",
+		"AWS_CONFIG_FILE":             filepath.Join(t.TempDir(), "config"),
+		"AWS_PROFILE":                 "",
+		"AWS_ROLE_ARN":                awsbase.MockStsAssumeRoleWithWebIdentityArn,
+		"AWS_ROLE_SESSION_NAME":       awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+		"AWS_SECRET_ACCESS_KEY":       "",
+		"AWS_SESSION_TOKEN":           "",
+		"AWS_SHARED_CREDENTIALS_FILE": filepath.Join(t.TempDir(), "credentials"),
+		"AWS_WEB_IDENTITY_TOKEN_FILE": tokenFile,
+	})
+
+	config := &Config{
+		Endpoints:               map[string]string{STS: server.URL},
+		Region:                  "us-east-1", // lintignore:AWSAT003
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	creds := raw.(*AWSClient).STSConn.Config.Credentials
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("error getting credentials: %s", err)
+	}
+
+	if err := ioutil.WriteFile(tokenFile, []byte("token2"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate the cached credentials expiring.
+	creds.Expire()
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("error refreshing credentials: %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if expected := []string{"token1", "token2"}; !reflect.DeepEqual(tokens, expected) {
+		t.Fatalf("AssumeRoleWithWebIdentity tokens: %q\nExpected: %q\n", tokens, expected)
+	}
+}
+
+// setenv sets the environment variables for the duration of the test.
+// Empty values unset the variable.
+func setenv(t *testing.T, vars map[string]string) {
+	for k, v := range vars {
+		old, ok := os.LookupEnv(k)
+
+		if v == "" {
+			os.Unsetenv(k)
+		} else {
+			os.Setenv(k, v)
+		}
+
+		k := k
+		t.Cleanup(func() {
+			if ok {
+				os.Setenv(k, old)
+			} else {
+				os.Unsetenv(k)
+			}
+		})
+	}
+}
+
 var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
   <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
   <accountAttributeSet>
@@ -788,6 +788,8 @@ func awsServiceName(s string) (string, error) {
 	switch s {
 	case "amp":
 		return "prometheusservice", nil
+	case "ce":
+		return "costexplorer", nil
 	case "cloudcontrol":
 		return "cloudcontrolapi", nil
 	case "cognitoidp":
@@ -823,6 +825,8 @@ func awsServiceNameUpper(s string) (string, error) {
 		return awsServiceNames["prometheusservice"], nil
 	case "appautoscaling":
 		return awsServiceNames["applicationautoscaling"], nil
+	case "ce":
+		return awsServiceNames["costexplorer"], nil
 	case "cloudcontrol":
 		return awsServiceNames["cloudcontrolapi"], nil
 	case "cognitoidp":
@@ -890,6 +894,7 @@ func init() {
 	awsServiceNames["braket"] = "Braket"
 	awsServiceNames["budgets"] = "Budgets"
 	awsServiceNames["chime"] = "Chime"
+	awsServiceNames["cleanrooms"] = "CleanRooms"
 	awsServiceNames["cloud9"] = "Cloud9"
 	awsServiceNames["cloudcontrolapi"] = "CloudControlApi"
 	awsServiceNames["clouddirectory"] = "CloudDirectory"
@@ -1061,6 +1066,7 @@ func init() {
 	awsServiceNames["pinpoint"] = "Pinpoint"
 	awsServiceNames["pinpointemail"] = "PinpointEmail"
 	awsServiceNames["pinpointsmsvoice"] = "PinpointSMSVoice"
+	awsServiceNames["pipes"] = "Pipes"
 	awsServiceNames["polly"] = "Polly"
 	awsServiceNames["pricing"] = "Pricing"
 	awsServiceNames["prometheusservice"] = "PrometheusService"
@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -27,8 +29,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ce"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
@@ -88,6 +93,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
@@ -116,6 +122,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -132,6 +139,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
@@ -154,6 +162,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
@@ -250,6 +259,13 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types, e.g. aws_autoscaling_group, to which the default tags are not applied.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -683,10 +699,11 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
 			"aws_route53_resolver_rules":    route53resolver.DataSourceRules(),
 
-			"aws_canonical_user_id": s3.DataSourceCanonicalUserID(),
-			"aws_s3_bucket":         s3.DataSourceBucket(),
-			"aws_s3_object":         s3.DataSourceObject(),
-			"aws_s3_objects":        s3.DataSourceObjects(),
+			"aws_canonical_user_id":                 s3.DataSourceCanonicalUserID(),
+			"aws_s3_bucket":                         s3.DataSourceBucket(),
+			"aws_s3_bucket_lifecycle_configuration": s3.DataSourceBucketLifecycleConfiguration(),
+			"aws_s3_object":                         s3.DataSourceObject(),
+			"aws_s3_objects":                        s3.DataSourceObjects(),
 
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
@@ -756,6 +773,7 @@ func Provider() *schema.Provider {
 			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
+			"aws_account_region":            account.ResourceRegion(),
 
 			"aws_acm_certificate":            acm.ResourceCertificate(),
 			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
@@ -882,9 +900,14 @@ func Provider() *schema.Provider {
 			"aws_batch_job_queue":           batch.ResourceJobQueue(),
 			"aws_batch_scheduling_policy":   batch.ResourceSchedulingPolicy(),
 
+			"aws_bedrock_custom_model": bedrock.ResourceCustomModel(),
+
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
 
+			"aws_ce_anomaly_monitor":      ce.ResourceAnomalyMonitor(),
+			"aws_ce_anomaly_subscription": ce.ResourceAnomalySubscription(),
+
 			"aws_chime_voice_connector":                         chime.ResourceVoiceConnector(),
 			"aws_chime_voice_connector_group":                   chime.ResourceVoiceConnectorGroup(),
 			"aws_chime_voice_connector_logging":                 chime.ResourceVoiceConnectorLogging(),
@@ -893,6 +916,9 @@ func Provider() *schema.Provider {
 			"aws_chime_voice_connector_termination":             chime.ResourceVoiceConnectorTermination(),
 			"aws_chime_voice_connector_termination_credentials": chime.ResourceVoiceConnectorTerminationCredentials(),
 
+			"aws_cleanrooms_collaboration": cleanrooms.ResourceCollaboration(),
+			"aws_cleanrooms_membership":    cleanrooms.ResourceMembership(),
+
 			"aws_cloud9_environment_ec2":        cloud9.ResourceEnvironmentEC2(),
 			"aws_cloud9_environment_membership": cloud9.ResourceEnvironmentMembership(),
 
@@ -938,14 +964,17 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_event_rule":            events.ResourceRule(),
 			"aws_cloudwatch_event_target":          events.ResourceTarget(),
 
-			"aws_cloudwatch_log_destination":         cloudwatchlogs.ResourceDestination(),
-			"aws_cloudwatch_log_destination_policy":  cloudwatchlogs.ResourceDestinationPolicy(),
-			"aws_cloudwatch_log_group":               cloudwatchlogs.ResourceGroup(),
-			"aws_cloudwatch_log_metric_filter":       cloudwatchlogs.ResourceMetricFilter(),
-			"aws_cloudwatch_log_resource_policy":     cloudwatchlogs.ResourceResourcePolicy(),
-			"aws_cloudwatch_log_stream":              cloudwatchlogs.ResourceStream(),
-			"aws_cloudwatch_log_subscription_filter": cloudwatchlogs.ResourceSubscriptionFilter(),
-			"aws_cloudwatch_query_definition":        cloudwatchlogs.ResourceQueryDefinition(),
+			"aws_cloudwatch_log_delivery":             cloudwatchlogs.ResourceDelivery(),
+			"aws_cloudwatch_log_delivery_destination": cloudwatchlogs.ResourceDeliveryDestination(),
+			"aws_cloudwatch_log_delivery_source":      cloudwatchlogs.ResourceDeliverySource(),
+			"aws_cloudwatch_log_destination":          cloudwatchlogs.ResourceDestination(),
+			"aws_cloudwatch_log_destination_policy":   cloudwatchlogs.ResourceDestinationPolicy(),
+			"aws_cloudwatch_log_group":                cloudwatchlogs.ResourceGroup(),
+			"aws_cloudwatch_log_metric_filter":        cloudwatchlogs.ResourceMetricFilter(),
+			"aws_cloudwatch_log_resource_policy":      cloudwatchlogs.ResourceResourcePolicy(),
+			"aws_cloudwatch_log_stream":               cloudwatchlogs.ResourceStream(),
+			"aws_cloudwatch_log_subscription_filter":  cloudwatchlogs.ResourceSubscriptionFilter(),
+			"aws_cloudwatch_query_definition":         cloudwatchlogs.ResourceQueryDefinition(),
 
 			"aws_codeartifact_domain":                        codeartifact.ResourceDomain(),
 			"aws_codeartifact_domain_permissions_policy":     codeartifact.ResourceDomainPermissionsPolicy(),
@@ -1104,6 +1133,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_snapshot_import":                             ec2.ResourceEBSSnapshotImport(),
 			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
 			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_block_reservation":                  ec2.ResourceCapacityBlockReservation(),
 			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
 			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
 			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
@@ -1319,6 +1349,7 @@ func Provider() *schema.Provider {
 			"aws_glue_workflow":                         glue.ResourceWorkflow(),
 
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
+			"aws_guardduty_detector_feature":           guardduty.ResourceDetectorFeature(),
 			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
 			"aws_guardduty_ipset":                      guardduty.ResourceIPSet(),
@@ -1363,6 +1394,9 @@ func Provider() *schema.Provider {
 			"aws_inspector_assessment_template": inspector.ResourceAssessmentTemplate(),
 			"aws_inspector_resource_group":      inspector.ResourceResourceGroup(),
 
+			"aws_inspector2_ec2_deep_inspection_configuration": inspector2.ResourceEC2DeepInspectionConfiguration(),
+			"aws_inspector2_enabler":                           inspector2.ResourceEnabler(),
+
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
 			"aws_iot_policy":                     iot.ResourcePolicy(),
@@ -1510,6 +1544,8 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
+			"aws_pipes_pipe": pipes.ResourcePipe(),
+
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
@@ -1560,6 +1596,7 @@ func Provider() *schema.Provider {
 			"aws_route53_key_signing_key":               route53.ResourceKeySigningKey(),
 			"aws_route53_query_log":                     route53.ResourceQueryLog(),
 			"aws_route53_record":                        route53.ResourceRecord(),
+			"aws_route53_records_exclusive":             route53.ResourceRecordsExclusive(),
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
@@ -1591,6 +1628,7 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_cors_configuration":                s3.ResourceBucketCorsConfiguration(),
 			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
 			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":           s3.ResourceBucketLifecycleConfiguration(),
 			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
 			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
 			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
@@ -1638,6 +1676,8 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_workforce":                                 sagemaker.ResourceWorkforce(),
 			"aws_sagemaker_workteam":                                  sagemaker.ResourceWorkteam(),
 
+			"aws_scheduler_schedule": scheduler.ResourceSchedule(),
+
 			"aws_schemas_discoverer": schemas.ResourceDiscoverer(),
 			"aws_schemas_registry":   schemas.ResourceRegistry(),
 			"aws_schemas_schema":     schemas.ResourceSchema(),
@@ -1711,14 +1751,16 @@ func Provider() *schema.Provider {
 
 			"aws_simpledb_domain": simpledb.ResourceDomain(),
 
-			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
-			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
-			"aws_sns_topic":                sns.ResourceTopic(),
-			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
-			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
+			"aws_sns_platform_application":         sns.ResourcePlatformApplication(),
+			"aws_sns_sms_preferences":              sns.ResourceSMSPreferences(),
+			"aws_sns_topic":                        sns.ResourceTopic(),
+			"aws_sns_topic_data_protection_policy": sns.ResourceTopicDataProtectionPolicy(),
+			"aws_sns_topic_policy":                 sns.ResourceTopicPolicy(),
+			"aws_sns_topic_subscription":           sns.ResourceTopicSubscription(),
 
-			"aws_sqs_queue":        sqs.ResourceQueue(),
-			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue":         sqs.ResourceQueue(),
+			"aws_sqs_queue_policy":  sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue_redrive": sqs.ResourceQueueRedrive(),
 
 			"aws_ssm_activation":                ssm.ResourceActivation(),
 			"aws_ssm_association":               ssm.ResourceAssociation(),
@@ -1730,6 +1772,7 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_baseline":            ssm.ResourcePatchBaseline(),
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
+			"aws_ssm_resource_policy":           ssm.ResourceResourcePolicy(),
 
 			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
 			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
@@ -1754,10 +1797,16 @@ func Provider() *schema.Provider {
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
 
-			"aws_transfer_access":  transfer.ResourceAccess(),
-			"aws_transfer_server":  transfer.ResourceServer(),
-			"aws_transfer_ssh_key": transfer.ResourceSSHKey(),
-			"aws_transfer_user":    transfer.ResourceUser(),
+			"aws_transfer_access":    transfer.ResourceAccess(),
+			"aws_transfer_connector": transfer.ResourceConnector(),
+			"aws_transfer_server":    transfer.ResourceServer(),
+			"aws_transfer_ssh_key":   transfer.ResourceSSHKey(),
+			"aws_transfer_user":      transfer.ResourceUser(),
+
+			"aws_verifiedpermissions_policy":          verifiedpermissions.ResourcePolicy(),
+			"aws_verifiedpermissions_policy_store":    verifiedpermissions.ResourcePolicyStore(),
+			"aws_verifiedpermissions_policy_template": verifiedpermissions.ResourcePolicyTemplate(),
+			"aws_verifiedpermissions_schema":          verifiedpermissions.ResourceSchema(),
 
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
@@ -1806,6 +1855,12 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	for name, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; ok {
+			wrapResourceDefaultTags(name, r)
+		}
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -2096,9 +2151,97 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(v)
 	}
+
+	if v, ok := m["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludeResourceTypes = make(map[string]struct{}, v.Len())
+
+		for _, resourceType := range v.List() {
+			defaultConfig.ExcludeResourceTypes[resourceType.(string)] = struct{}{}
+		}
+	}
+
 	return defaultConfig
 }
 
+// resourceDefaultTagsMeta returns the provider meta to use for the given resource type.
+// If default tags are excluded from the resource type, a copy of the client
+// without a DefaultTagsConfig is returned.
+func resourceDefaultTagsMeta(resourceType string, meta interface{}) interface{} {
+	client, ok := meta.(*conns.AWSClient)
+	if !ok || client.DefaultTagsConfig == nil || client.DefaultTagsConfig.ForResourceType(resourceType) != nil {
+		return meta
+	}
+
+	c := *client
+	c.DefaultTagsConfig = nil
+
+	return &c
+}
+
+// wrapResourceDefaultTags wraps the resource's functions so that they receive
+// the provider meta for the resource type, see resourceDefaultTagsMeta.
+func wrapResourceDefaultTags(resourceType string, r *schema.Resource) {
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.CreateWithoutTimeout; f != nil {
+		r.CreateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.ReadWithoutTimeout; f != nil {
+		r.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.UpdateWithoutTimeout; f != nil {
+		r.UpdateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return f(ctx, diff, resourceDefaultTagsMeta(resourceType, meta))
+		}
+	}
+}
+
 func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -0,0 +1,246 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRegion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRegionPut,
+		ReadContext:   resourceRegionRead,
+		UpdateContext: resourceRegionPut,
+		DeleteContext: resourceRegionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"opt_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRegionPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	accountID := d.Get("account_id").(string)
+	regionName := d.Get("region_name").(string)
+	id := RegionCreateResourceID(accountID, regionName)
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	if d.Get("enabled").(bool) {
+		input := &account.EnableRegionInput{
+			RegionName: aws.String(regionName),
+		}
+
+		if accountID != "" {
+			input.AccountId = aws.String(accountID)
+		}
+
+		log.Printf("[DEBUG] Enabling Account Region: %s", input)
+		_, err := conn.EnableRegionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error enabling Account Region (%s): %s", id, err)
+		}
+
+		if _, err := waitRegionEnabled(ctx, conn, accountID, regionName, timeout); err != nil {
+			return diag.Errorf("error waiting for Account Region (%s) enable: %s", id, err)
+		}
+	} else {
+		input := &account.DisableRegionInput{
+			RegionName: aws.String(regionName),
+		}
+
+		if accountID != "" {
+			input.AccountId = aws.String(accountID)
+		}
+
+		log.Printf("[DEBUG] Disabling Account Region: %s", input)
+		_, err := conn.DisableRegionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error disabling Account Region (%s): %s", id, err)
+		}
+
+		if _, err := waitRegionDisabled(ctx, conn, accountID, regionName, timeout); err != nil {
+			return diag.Errorf("error waiting for Account Region (%s) disable: %s", id, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceRegionRead(ctx, d, meta)
+}
+
+func resourceRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	accountID, regionName, err := RegionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindRegionOptStatus(ctx, conn, accountID, regionName)
+
+	if err != nil {
+		return diag.Errorf("error reading Account Region (%s): %s", d.Id(), err)
+	}
+
+	optStatus := aws.StringValue(output.RegionOptStatus)
+
+	d.Set("account_id", accountID)
+	d.Set("enabled", optStatus == account.RegionOptStatusEnabled || optStatus == account.RegionOptStatusEnabledByDefault)
+	d.Set("opt_status", optStatus)
+	d.Set("region_name", output.RegionName)
+
+	return nil
+}
+
+func resourceRegionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The Region is left in its current state.
+	log.Printf("[WARN] Account Region (%s) is not enabled or disabled on destroy, removing from state only", d.Id())
+
+	return nil
+}
+
+func FindRegionOptStatus(ctx context.Context, conn *account.Account, accountID, regionName string) (*account.GetRegionOptStatusOutput, error) {
+	input := &account.GetRegionOptStatusInput{
+		RegionName: aws.String(regionName),
+	}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	output, err := conn.GetRegionOptStatusWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRegionOptStatus(ctx context.Context, conn *account.Account, accountID, regionName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRegionOptStatus(ctx, conn, accountID, regionName)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.RegionOptStatus), nil
+	}
+}
+
+func waitRegionEnabled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		// The previous status may be reported for a short time after enabling.
+		Pending: []string{account.RegionOptStatusEnabling, account.RegionOptStatusDisabled},
+		Target:  []string{account.RegionOptStatusEnabled, account.RegionOptStatusEnabledByDefault},
+		Refresh: statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRegionDisabled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		// The previous status may be reported for a short time after disabling.
+		Pending: []string{account.RegionOptStatusDisabling, account.RegionOptStatusEnabled},
+		Target:  []string{account.RegionOptStatusDisabled},
+		Refresh: statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const regionResourceIDSeparator = "/"
+
+func RegionCreateResourceID(accountID, regionName string) string {
+	if accountID == "" {
+		return regionName
+	}
+
+	parts := []string{accountID, regionName}
+	id := strings.Join(parts, regionResourceIDSeparator)
+
+	return id
+}
+
+func RegionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, regionResourceIDSeparator)
+
+	switch len(parts) {
+	case 1:
+		return "", parts[0], nil
+	case 2:
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected RegionName or AccountID%[2]sRegionName", id, regionResourceIDSeparator)
+	}
+}
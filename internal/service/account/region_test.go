@@ -0,0 +1,96 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
+)
+
+func TestAccAccountRegion_basic(t *testing.T) {
+	resourceName := "aws_account_region.test"
+	regionName := "ap-southeast-3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		// The Region is left in its current state on destroy, so there is nothing to check.
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionConfig(regionName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRegionOptStatus(resourceName, account.RegionOptStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "region_name", regionName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegionConfig(regionName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRegionOptStatus(resourceName, account.RegionOptStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRegionOptStatus(n, optStatus string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Account Region ID is set")
+		}
+
+		accountID, regionName, err := tfaccount.RegionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		ctx := context.TODO()
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn
+
+		output, err := tfaccount.FindRegionOptStatus(ctx, conn, accountID, regionName)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.RegionOptStatus); got != optStatus {
+			return fmt.Errorf("Account Region (%s) opt status is %s, expected %s", rs.Primary.ID, got, optStatus)
+		}
+
+		return nil
+	}
+}
+
+func testAccRegionConfig(regionName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_account_region" "test" {
+  region_name = %[1]q
+  enabled     = %[2]t
+}
+`, regionName, enabled)
+}
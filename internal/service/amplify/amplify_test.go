@@ -20,6 +20,7 @@ func TestAccAmplify_serial(t *testing.T) {
 			"EnvironmentVariables":     testAccApp_EnvironmentVariables,
 			"IamServiceRole":           testAccApp_IAMServiceRole,
 			"Name":                     testAccApp_Name,
+			"Platform":                 testAccApp_Platform,
 			"Repository":               testAccApp_Repository,
 		},
 		"BackendEnvironment": {
@@ -534,6 +534,44 @@ func testAccApp_Name(t *testing.T) {
 	})
 }
 
+func testAccApp_Platform(t *testing.T) {
+	var app1, app2 amplify.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, amplify.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppPlatformWebComputeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &app1),
+					resource.TestCheckResourceAttr(resourceName, "platform", "WEB_COMPUTE"),
+					resource.TestCheckResourceAttrSet(resourceName, "build_spec"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables._CUSTOM_IMAGE", "amplify:al2023"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppNameConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &app2),
+					testAccCheckAppNotRecreated(&app1, &app2),
+					resource.TestCheckResourceAttr(resourceName, "platform", "WEB"),
+				),
+			},
+		},
+	})
+}
+
 func testAccApp_Repository(t *testing.T) {
 	key := "AMPLIFY_GITHUB_ACCESS_TOKEN"
 	accessToken := os.Getenv(key)
@@ -908,6 +946,40 @@ resource "aws_amplify_app" "test" {
 `, rName))
 }
 
+func testAccAppPlatformWebComputeConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name     = %[1]q
+  platform = "WEB_COMPUTE"
+
+  # A Next.js server-side rendered (SSR) app.
+  build_spec = <<-EOT
+    version: 1
+    frontend:
+      phases:
+        preBuild:
+          commands:
+            - npm ci
+        build:
+          commands:
+            - npm run build
+      artifacts:
+        baseDirectory: .next
+        files:
+          - '**/*'
+      cache:
+        paths:
+          - node_modules/**/*
+          - .next/cache/**/*
+  EOT
+
+  environment_variables = {
+    _CUSTOM_IMAGE = "amplify:al2023"
+  }
+}
+`, rName)
+}
+
 func testAccAppRepositoryConfig(rName, repository, accessToken string) string {
 	return fmt.Sprintf(`
 resource "aws_amplify_app" "test" {
@@ -93,7 +93,6 @@ func ResourceDomainName() *schema.Resource {
 						"truststore_uri": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"truststore_version": {
 							Type:     schema.TypeString,
@@ -206,9 +205,9 @@ func resourceDomainNameUpdate(d *schema.ResourceData, meta interface{}) error {
 					TruststoreUri: aws.String(""),
 				}
 			} else {
-				input.MutualTlsAuthentication = &apigatewayv2.MutualTlsAuthenticationInput{
-					TruststoreVersion: aws.String(vMutualTlsAuthentication[0].(map[string]interface{})["truststore_version"].(string)),
-				}
+				// The truststore is updated in place. Both its URI and version must be sent,
+				// otherwise API Gateway drops the truststore from the domain name.
+				input.MutualTlsAuthentication = expandApiGatewayV2MutualTlsAuthentication(vMutualTlsAuthentication)
 			}
 		}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/kafka"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -53,6 +54,11 @@ func TestValidateAppAutoScalingPolicyImportInput(t *testing.T) {
 			expected:      []string{"elasticmapreduce", "instancegroup/j-2EEZNYKUA1NTV/ig-1791Y4E1L8YI0", "elasticmapreduce:instancegroup:InstanceCount", "test-appautoscaling-policy-ruuhd"},
 			errorExpected: false,
 		},
+		{
+			input:         "kafka/arn:aws:kafka:us-east-1:123456789012:cluster/sample-cluster/a1b2c3d4-5678-90ab-cdef-11111EXAMPLE-1/kafka:broker-storage:VolumeSize/broker-storage-scaling",
+			expected:      []string{"kafka", "arn:aws:kafka:us-east-1:123456789012:cluster/sample-cluster/a1b2c3d4-5678-90ab-cdef-11111EXAMPLE-1", "kafka:broker-storage:VolumeSize", "broker-storage-scaling"},
+			errorExpected: false,
+		},
 		{
 			input:         "rds/cluster:id/rds:cluster:ReadReplicaCount/cpu-auto-scaling",
 			expected:      []string{"rds", "cluster:id", "rds:cluster:ReadReplicaCount", "cpu-auto-scaling"},
@@ -321,6 +327,40 @@ func TestAccAppAutoScalingPolicy_DynamoDB_index(t *testing.T) {
 	})
 }
 
+func TestAccAppAutoScalingPolicy_MSK_brokerStorage(t *testing.T) {
+	var policy applicationautoscaling.ScalingPolicy
+	resourceName := "aws_appautoscaling_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafka.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, applicationautoscaling.EndpointsID, kafka.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyMSKBrokerStorageConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "TargetTrackingScaling"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id", "aws_msk_cluster.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "scalable_dimension", "kafka:broker-storage:VolumeSize"),
+					resource.TestCheckResourceAttr(resourceName, "service_namespace", "kafka"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.predefined_metric_specification.0.predefined_metric_type", "KafkaBrokerStorageUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.target_value", "55"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAppAutoScalingPolicy_multiplePoliciesSameName(t *testing.T) {
 	var readPolicy1 applicationautoscaling.ScalingPolicy
 	var readPolicy2 applicationautoscaling.ScalingPolicy
@@ -646,6 +686,81 @@ resource "aws_appautoscaling_policy" "test" {
 `, randPolicyName, validUntil)
 }
 
+func testAccPolicyMSKBrokerStorageConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "192.168.0.0/22"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 3
+
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 2, count.index)
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.7.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = aws_subnet.test[*].id
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.test.id]
+  }
+
+  lifecycle {
+    ignore_changes = [broker_node_group_info[0].ebs_volume_size]
+  }
+}
+
+resource "aws_appautoscaling_target" "test" {
+  max_capacity       = 100
+  min_capacity       = 1
+  resource_id        = aws_msk_cluster.test.arn
+  scalable_dimension = "kafka:broker-storage:VolumeSize"
+  service_namespace  = "kafka"
+}
+
+resource "aws_appautoscaling_policy" "test" {
+  name               = %[1]q
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+
+  target_tracking_scaling_policy_configuration {
+    disable_scale_in = true
+
+    predefined_metric_specification {
+      predefined_metric_type = "KafkaBrokerStorageUtilization"
+    }
+
+    target_value = 55
+  }
+}
+`, rName))
+}
+
 func testAccPolicyDynamoDB(
 	randPolicyName string) string {
 	return fmt.Sprintf(`
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -152,6 +153,78 @@ func ResourceService() *schema.Resource {
 				},
 			},
 
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"egress_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"egress_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      apprunner.EgressTypeDefault,
+										ValidateFunc: validation.StringInSlice(apprunner.EgressType_Values(), false),
+									},
+									"vpc_connector_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"ingress_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"is_publicly_accessible": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+						"ip_address_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      apprunner.IpAddressTypeIpv4,
+							ValidateFunc: validation.StringInSlice(apprunner.IpAddressType_Values(), false),
+						},
+					},
+				},
+			},
+
+			"observability_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"observability_configuration_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"observability_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"service_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -346,10 +419,46 @@ func ResourceService() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceServiceCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceServiceCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		// Dual-stack (IPv4 and IPv6) addressing is only supported for public incoming traffic.
+		if tfMap["ip_address_type"].(string) == apprunner.IpAddressTypeDualStack {
+			if v, ok := tfMap["ingress_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				if !v[0].(map[string]interface{})["is_publicly_accessible"].(bool) {
+					return fmt.Errorf("network_configuration.0.ip_address_type %q requires network_configuration.0.ingress_configuration.0.is_publicly_accessible to be true", apprunner.IpAddressTypeDualStack)
+				}
+			}
+		}
+
+		if v, ok := tfMap["egress_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if tfMap["egress_type"].(string) == apprunner.EgressTypeVpc && tfMap["vpc_connector_arn"].(string) == "" {
+				return fmt.Errorf("network_configuration.0.egress_configuration.0.vpc_connector_arn must be set when egress_type is %q", apprunner.EgressTypeVpc)
+			}
+		}
+	}
+
+	if v, ok := diff.GetOk("observability_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if tfMap["observability_enabled"].(bool) && tfMap["observability_configuration_arn"].(string) == "" {
+			return fmt.Errorf("observability_configuration.0.observability_configuration_arn must be set when observability_enabled is true")
+		}
+	}
+
+	return nil
+}
+
 func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).AppRunnerConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -379,6 +488,14 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.InstanceConfiguration = expandAppRunnerServiceInstanceConfiguration(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NetworkConfiguration = expandAppRunnerServiceNetworkConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("observability_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ObservabilityConfiguration = expandAppRunnerServiceObservabilityConfiguration(v.([]interface{}))
+	}
+
 	var output *apprunner.CreateServiceOutput
 
 	err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
@@ -477,6 +594,14 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(fmt.Errorf("error setting instance_configuration: %w", err))
 	}
 
+	if err := d.Set("network_configuration", flattenAppRunnerServiceNetworkConfiguration(service.NetworkConfiguration)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting network_configuration: %w", err))
+	}
+
+	if err := d.Set("observability_configuration", flattenAppRunnerServiceObservabilityConfiguration(service.ObservabilityConfiguration)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting observability_configuration: %w", err))
+	}
+
 	if err := d.Set("source_configuration", flattenAppRunnerServiceSourceConfiguration(service.SourceConfiguration)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting source_configuration: %w", err))
 	}
@@ -507,6 +632,8 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	if d.HasChanges(
 		"auto_scaling_configuration_arn",
 		"instance_configuration",
+		"network_configuration",
+		"observability_configuration",
 		"source_configuration",
 	) {
 		input := &apprunner.UpdateServiceInput{
@@ -521,6 +648,21 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.InstanceConfiguration = expandAppRunnerServiceInstanceConfiguration(d.Get("instance_configuration").([]interface{}))
 		}
 
+		if d.HasChange("network_configuration") {
+			input.NetworkConfiguration = expandAppRunnerServiceNetworkConfiguration(d.Get("network_configuration").([]interface{}))
+		}
+
+		if d.HasChange("observability_configuration") {
+			// Removing the block disables observability.
+			input.ObservabilityConfiguration = &apprunner.ServiceObservabilityConfiguration{
+				ObservabilityEnabled: aws.Bool(false),
+			}
+
+			if v, ok := d.GetOk("observability_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ObservabilityConfiguration = expandAppRunnerServiceObservabilityConfiguration(v.([]interface{}))
+			}
+		}
+
 		if d.HasChange("source_configuration") {
 			input.SourceConfiguration = expandAppRunnerServiceSourceConfiguration(d.Get("source_configuration").([]interface{}))
 		}
@@ -663,6 +805,73 @@ func expandAppRunnerServiceInstanceConfiguration(l []interface{}) *apprunner.Ins
 	return result
 }
 
+func expandAppRunnerServiceNetworkConfiguration(l []interface{}) *apprunner.NetworkConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	result := &apprunner.NetworkConfiguration{}
+
+	if v, ok := tfMap["egress_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		egress := &apprunner.EgressConfiguration{}
+
+		if v, ok := tfMap["egress_type"].(string); ok && v != "" {
+			egress.EgressType = aws.String(v)
+		}
+
+		if v, ok := tfMap["vpc_connector_arn"].(string); ok && v != "" {
+			egress.VpcConnectorArn = aws.String(v)
+		}
+
+		result.EgressConfiguration = egress
+	}
+
+	if v, ok := tfMap["ingress_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		result.IngressConfiguration = &apprunner.IngressConfiguration{
+			IsPubliclyAccessible: aws.Bool(tfMap["is_publicly_accessible"].(bool)),
+		}
+	}
+
+	if v, ok := tfMap["ip_address_type"].(string); ok && v != "" {
+		result.IpAddressType = aws.String(v)
+	}
+
+	return result
+}
+
+func expandAppRunnerServiceObservabilityConfiguration(l []interface{}) *apprunner.ServiceObservabilityConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	result := &apprunner.ServiceObservabilityConfiguration{}
+
+	if v, ok := tfMap["observability_configuration_arn"].(string); ok && v != "" {
+		result.ObservabilityConfigurationArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["observability_enabled"].(bool); ok {
+		result.ObservabilityEnabled = aws.Bool(v)
+	}
+
+	return result
+}
+
 func expandAppRunnerServiceSourceConfiguration(l []interface{}) *apprunner.SourceConfiguration {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -986,6 +1195,44 @@ func flattenAppRunnerServiceSourceCodeVersion(v *apprunner.SourceCodeVersion) []
 	return []interface{}{m}
 }
 
+func flattenAppRunnerServiceNetworkConfiguration(config *apprunner.NetworkConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"ip_address_type": aws.StringValue(config.IpAddressType),
+	}
+
+	if v := config.EgressConfiguration; v != nil {
+		m["egress_configuration"] = []interface{}{map[string]interface{}{
+			"egress_type":       aws.StringValue(v.EgressType),
+			"vpc_connector_arn": aws.StringValue(v.VpcConnectorArn),
+		}}
+	}
+
+	if v := config.IngressConfiguration; v != nil {
+		m["ingress_configuration"] = []interface{}{map[string]interface{}{
+			"is_publicly_accessible": aws.BoolValue(v.IsPubliclyAccessible),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAppRunnerServiceObservabilityConfiguration(config *apprunner.ServiceObservabilityConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"observability_configuration_arn": aws.StringValue(config.ObservabilityConfigurationArn),
+		"observability_enabled":           aws.BoolValue(config.ObservabilityEnabled),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAppRunnerServiceSourceConfiguration(config *apprunner.SourceConfiguration) []interface{} {
 	if config == nil {
 		return []interface{}{}
@@ -302,6 +302,96 @@ func TestAccAppRunnerService_ImageRepository_runtimeEnvironmentVars(t *testing.T
 	})
 }
 
+func TestAccAppRunnerService_ImageRepository_networkConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerService_imageRepository_networkConfiguration(rName, apprunner.IpAddressTypeIpv4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.egress_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.egress_configuration.0.egress_type", apprunner.EgressTypeDefault),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.ingress_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.ingress_configuration.0.is_publicly_accessible", "true"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.ip_address_type", apprunner.IpAddressTypeIpv4),
+					resource.TestCheckResourceAttr(resourceName, "status", apprunner.ServiceStatusRunning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppRunnerService_imageRepository_networkConfiguration(rName, apprunner.IpAddressTypeDualStack),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.ip_address_type", apprunner.IpAddressTypeDualStack),
+					resource.TestCheckResourceAttr(resourceName, "status", apprunner.ServiceStatusRunning),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerService_ImageRepository_networkConfigurationDualStackPrivate(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAppRunnerService_imageRepository_networkConfigurationDualStackPrivate(rName),
+				ExpectError: regexp.MustCompile(`requires network_configuration.0.ingress_configuration.0.is_publicly_accessible to be true`),
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerService_ImageRepository_observabilityConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerService_imageRepository_observabilityConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.0.observability_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.0.observability_configuration_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccAppRunnerService_imageRepository_observabilityConfigurationNoARN(rName),
+				ExpectError: regexp.MustCompile(`observability_configuration_arn must be set when observability_enabled is true`),
+			},
+		},
+	})
+}
+
 func TestAccAppRunnerService_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
@@ -587,6 +677,103 @@ resource "aws_apprunner_service" "test" {
 `, rName)
 }
 
+func testAccAppRunnerService_imageRepository_networkConfiguration(rName, ipAddressType string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+
+  network_configuration {
+    egress_configuration {
+      egress_type = "DEFAULT"
+    }
+    ingress_configuration {
+      is_publicly_accessible = true
+    }
+    ip_address_type = %[2]q
+  }
+}
+`, rName, ipAddressType)
+}
+
+func testAccAppRunnerService_imageRepository_networkConfigurationDualStackPrivate(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+
+  network_configuration {
+    ingress_configuration {
+      is_publicly_accessible = false
+    }
+    ip_address_type = "DUAL_STACK"
+  }
+}
+`, rName)
+}
+
+func testAccAppRunnerService_imageRepository_observabilityConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+
+  observability_configuration {
+    observability_enabled = false
+  }
+}
+`, rName)
+}
+
+func testAccAppRunnerService_imageRepository_observabilityConfigurationNoARN(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+
+  observability_configuration {
+    observability_enabled = true
+  }
+}
+`, rName)
+}
+
 func testAccAppRunnerIAMRole(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
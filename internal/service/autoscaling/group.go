@@ -268,6 +268,26 @@ func ResourceGroup() *schema.Resource {
 				Optional: true,
 			},
 
+			"instance_maintenance_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 200),
+						},
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+
 			"default_cooldown": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -607,10 +627,33 @@ func ResourceGroup() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			resourceGroupInstanceMaintenancePolicyCustomizeDiff,
 		),
 	}
 }
 
+func resourceGroupInstanceMaintenancePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("instance_maintenance_policy")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	if !diff.NewValueKnown("instance_maintenance_policy.0.max_healthy_percentage") || !diff.NewValueKnown("instance_maintenance_policy.0.min_healthy_percentage") {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	maxHealthyPercentage := tfMap["max_healthy_percentage"].(int)
+	minHealthyPercentage := tfMap["min_healthy_percentage"].(int)
+
+	if maxHealthyPercentage-minHealthyPercentage > 100 {
+		return fmt.Errorf("instance_maintenance_policy: the difference between max_healthy_percentage (%d) and min_healthy_percentage (%d) cannot be greater than 100", maxHealthyPercentage, minHealthyPercentage)
+	}
+
+	return nil
+}
+
 func generatePutLifecycleHookInputs(asgName string, cfgs []interface{}) []autoscaling.PutLifecycleHookInput {
 	res := make([]autoscaling.PutLifecycleHookInput, 0, len(cfgs))
 
@@ -756,6 +799,10 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 		createOpts.MaxInstanceLifetime = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("instance_maintenance_policy"); ok {
+		createOpts.InstanceMaintenancePolicy = expandAutoScalingInstanceMaintenancePolicy(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Auto Scaling Group create configuration: %#v", createOpts)
 
 	// Retry for IAM eventual consistency
@@ -888,6 +935,10 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("service_linked_role_arn", g.ServiceLinkedRoleARN)
 	d.Set("max_instance_lifetime", g.MaxInstanceLifetime)
 
+	if err := d.Set("instance_maintenance_policy", flattenAutoScalingInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
+		return fmt.Errorf("error setting instance_maintenance_policy: %s", err)
+	}
+
 	if err := d.Set("suspended_processes", flattenASGSuspendedProcesses(g.SuspendedProcesses)); err != nil {
 		return fmt.Errorf("error setting suspended_processes: %s", err)
 	}
@@ -1083,6 +1134,19 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		opts.MaxInstanceLifetime = aws.Int64(int64(d.Get("max_instance_lifetime").(int)))
 	}
 
+	if d.HasChange("instance_maintenance_policy") {
+		// If the instance maintenance policy is removed, we need to explicitly set
+		// both percentages to -1, or the API won't reset it for us.
+		if v, ok := d.GetOk("instance_maintenance_policy"); ok && len(v.([]interface{})) > 0 {
+			opts.InstanceMaintenancePolicy = expandAutoScalingInstanceMaintenancePolicy(v.([]interface{}))
+		} else {
+			opts.InstanceMaintenancePolicy = &autoscaling.InstanceMaintenancePolicy{
+				MaxHealthyPercentage: aws.Int64(-1),
+				MinHealthyPercentage: aws.Int64(-1),
+			}
+		}
+	}
+
 	if d.HasChange("health_check_grace_period") {
 		opts.HealthCheckGracePeriod = aws.Int64(int64(d.Get("health_check_grace_period").(int)))
 	}
@@ -1975,6 +2039,19 @@ func expandMixedInstancesLaunchTemplateSpecification(l []interface{}) *autoscali
 	return launchTemplateSpecification
 }
 
+func expandAutoScalingInstanceMaintenancePolicy(l []interface{}) *autoscaling.InstanceMaintenancePolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &autoscaling.InstanceMaintenancePolicy{
+		MaxHealthyPercentage: aws.Int64(int64(m["max_healthy_percentage"].(int))),
+		MinHealthyPercentage: aws.Int64(int64(m["min_healthy_percentage"].(int))),
+	}
+}
+
 func expandAutoScalingMixedInstancesPolicy(l []interface{}) *autoscaling.MixedInstancesPolicy {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2056,6 +2133,24 @@ func flattenAutoScalingLaunchTemplateSpecification(launchTemplateSpecification *
 	return []interface{}{m}
 }
 
+func flattenAutoScalingInstanceMaintenancePolicy(instanceMaintenancePolicy *autoscaling.InstanceMaintenancePolicy) []interface{} {
+	if instanceMaintenancePolicy == nil {
+		return []interface{}{}
+	}
+
+	// A cleared policy is returned with unset or -1 percentages.
+	if v := instanceMaintenancePolicy.MaxHealthyPercentage; v == nil || aws.Int64Value(v) == -1 {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_healthy_percentage": aws.Int64Value(instanceMaintenancePolicy.MaxHealthyPercentage),
+		"min_healthy_percentage": aws.Int64Value(instanceMaintenancePolicy.MinHealthyPercentage),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAutoScalingMixedInstancesPolicy(mixedInstancesPolicy *autoscaling.MixedInstancesPolicy) []interface{} {
 	if mixedInstancesPolicy == nil {
 		return []interface{}{}
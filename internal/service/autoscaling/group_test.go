@@ -735,6 +735,67 @@ func TestAccAutoScalingGroup_maxInstanceLifetime(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_instanceMaintenancePolicy(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGroupConfig_instanceMaintenancePolicy(rName, 210, 100),
+				ExpectError: regexp.MustCompile(`expected instance_maintenance_policy.0.max_healthy_percentage to be in the range \(100 - 200\)`),
+			},
+			{
+				Config:      testAccGroupConfig_instanceMaintenancePolicy(rName, 200, 90),
+				ExpectError: regexp.MustCompile(`the difference between max_healthy_percentage \(200\) and min_healthy_percentage \(90\) cannot be greater than 100`),
+			},
+			{
+				Config: testAccGroupConfig_instanceMaintenancePolicy(rName, 120, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_maintenance_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_maintenance_policy.0.max_healthy_percentage", "120"),
+					resource.TestCheckResourceAttr(resourceName, "instance_maintenance_policy.0.min_healthy_percentage", "90"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_delete",
+					"initial_lifecycle_hook",
+					"tag",
+					"tags",
+					"wait_for_capacity_timeout",
+					"wait_for_elb_capacity",
+				},
+			},
+			{
+				Config: testAccGroupConfig_instanceMaintenancePolicy(rName, 200, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_maintenance_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_maintenance_policy.0.max_healthy_percentage", "200"),
+					resource.TestCheckResourceAttr(resourceName, "instance_maintenance_policy.0.min_healthy_percentage", "100"),
+				),
+			},
+			{
+				Config: testAccGroupConfig_instanceMaintenancePolicyRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_maintenance_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_ALB_targetGroups(t *testing.T) {
 	var group autoscaling.Group
 	var tg elbv2.TargetGroup
@@ -2970,6 +3031,57 @@ resource "aws_autoscaling_group" "bar" {
 `)
 }
 
+func testAccGroupConfig_instanceMaintenancePolicyBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+data "aws_ami" "test_ami" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_configuration" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.test_ami.id
+  instance_type = "t2.micro"
+}
+`, rName))
+}
+
+func testAccGroupConfig_instanceMaintenancePolicy(rName string, maxHealthyPercentage, minHealthyPercentage int) string {
+	return acctest.ConfigCompose(testAccGroupConfig_instanceMaintenancePolicyBase(rName), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  desired_capacity     = 0
+  max_size             = 0
+  min_size             = 0
+  launch_configuration = aws_launch_configuration.test.name
+
+  instance_maintenance_policy {
+    max_healthy_percentage = %[2]d
+    min_healthy_percentage = %[3]d
+  }
+}
+`, rName, maxHealthyPercentage, minHealthyPercentage))
+}
+
+func testAccGroupConfig_instanceMaintenancePolicyRemoved(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_instanceMaintenancePolicyBase(rName), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  desired_capacity     = 0
+  max_size             = 0
+  min_size             = 0
+  launch_configuration = aws_launch_configuration.test.name
+}
+`, rName))
+}
+
 func testAccMetricsCollectionConfig_allMetricsCollected() string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
 		`
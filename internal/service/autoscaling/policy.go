@@ -87,6 +87,47 @@ func ResourcePolicy() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"customized_capacity_metric_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										ConflictsWith: []string{
+											"predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification",
+										},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_data_queries": customizedMetricDataQuerySchema(),
+											},
+										},
+									},
+									"customized_load_metric_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										ConflictsWith: []string{
+											"predictive_scaling_configuration.0.metric_specification.0.predefined_load_metric_specification",
+											"predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification",
+										},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_data_queries": customizedMetricDataQuerySchema(),
+											},
+										},
+									},
+									"customized_scaling_metric_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										ConflictsWith: []string{
+											"predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification",
+											"predictive_scaling_configuration.0.metric_specification.0.predefined_scaling_metric_specification",
+										},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_data_queries": customizedMetricDataQuerySchema(),
+											},
+										},
+									},
 									"predefined_metric_pair_specification": {
 										Type:     schema.TypeList,
 										Optional: true,
@@ -303,6 +344,88 @@ func ResourcePolicy() *schema.Resource {
 	}
 }
 
+func customizedMetricDataQuerySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"expression": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1023),
+				},
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"metric_stat": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"metric": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dimensions": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+										"metric_name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"namespace": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"stat": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 100),
+							},
+							"unit": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"return_data": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
 func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AutoScalingConn
 
@@ -435,8 +558,14 @@ func getPutScalingPolicyInput(d *schema.ResourceData) (autoscaling.PutScalingPol
 		params.AdjustmentType = aws.String(v.(string))
 	}
 
+	// This parameter is required if the policy type is PredictiveScaling and not supported otherwise.
 	if predictiveScalingConfigFlat := d.Get("predictive_scaling_configuration").([]interface{}); len(predictiveScalingConfigFlat) > 0 {
 		params.PredictiveScalingConfiguration = expandPredictiveScalingConfig(predictiveScalingConfigFlat)
+		if policyType != "PredictiveScaling" {
+			return params, fmt.Errorf("predictive_scaling_configuration is only supported for policy type PredictiveScaling")
+		}
+	} else if policyType == "PredictiveScaling" {
+		return params, fmt.Errorf("predictive_scaling_configuration is required for policy type PredictiveScaling")
 	}
 
 	// This parameter is supported if the policy type is SimpleScaling.
@@ -637,14 +766,112 @@ func expandPredictiveScalingMetricSpecifications(metricSpecificationsSlice []int
 	}
 	metricSpecificationsFlat := metricSpecificationsSlice[0].(map[string]interface{})
 	metricSpecification := &autoscaling.PredictiveScalingMetricSpecification{
-		PredefinedLoadMetricSpecification:    expandPredefinedLoadMetricSpecification(metricSpecificationsFlat["predefined_load_metric_specification"].([]interface{})),
-		PredefinedMetricPairSpecification:    expandPredefinedMetricPairSpecification(metricSpecificationsFlat["predefined_metric_pair_specification"].([]interface{})),
-		PredefinedScalingMetricSpecification: expandPredefinedScalingMetricSpecification(metricSpecificationsFlat["predefined_scaling_metric_specification"].([]interface{})),
-		TargetValue:                          aws.Float64(float64(metricSpecificationsFlat["target_value"].(int))),
+		CustomizedCapacityMetricSpecification: expandCustomizedCapacityMetricSpecification(metricSpecificationsFlat["customized_capacity_metric_specification"].([]interface{})),
+		CustomizedLoadMetricSpecification:     expandCustomizedLoadMetricSpecification(metricSpecificationsFlat["customized_load_metric_specification"].([]interface{})),
+		CustomizedScalingMetricSpecification:  expandCustomizedScalingMetricSpecification(metricSpecificationsFlat["customized_scaling_metric_specification"].([]interface{})),
+		PredefinedLoadMetricSpecification:     expandPredefinedLoadMetricSpecification(metricSpecificationsFlat["predefined_load_metric_specification"].([]interface{})),
+		PredefinedMetricPairSpecification:     expandPredefinedMetricPairSpecification(metricSpecificationsFlat["predefined_metric_pair_specification"].([]interface{})),
+		PredefinedScalingMetricSpecification:  expandPredefinedScalingMetricSpecification(metricSpecificationsFlat["predefined_scaling_metric_specification"].([]interface{})),
+		TargetValue:                           aws.Float64(float64(metricSpecificationsFlat["target_value"].(int))),
 	}
 	return []*autoscaling.PredictiveScalingMetricSpecification{metricSpecification}
 }
 
+func expandCustomizedCapacityMetricSpecification(customizedCapacityMetricSlice []interface{}) *autoscaling.PredictiveScalingCustomizedCapacityMetric {
+	if customizedCapacityMetricSlice == nil || len(customizedCapacityMetricSlice) < 1 || customizedCapacityMetricSlice[0] == nil {
+		return nil
+	}
+	customizedCapacityMetricFlat := customizedCapacityMetricSlice[0].(map[string]interface{})
+	customizedCapacityMetric := &autoscaling.PredictiveScalingCustomizedCapacityMetric{
+		MetricDataQueries: expandMetricDataQueries(customizedCapacityMetricFlat["metric_data_queries"].([]interface{})),
+	}
+	return customizedCapacityMetric
+}
+
+func expandCustomizedLoadMetricSpecification(customizedLoadMetricSlice []interface{}) *autoscaling.PredictiveScalingCustomizedLoadMetric {
+	if customizedLoadMetricSlice == nil || len(customizedLoadMetricSlice) < 1 || customizedLoadMetricSlice[0] == nil {
+		return nil
+	}
+	customizedLoadMetricFlat := customizedLoadMetricSlice[0].(map[string]interface{})
+	customizedLoadMetric := &autoscaling.PredictiveScalingCustomizedLoadMetric{
+		MetricDataQueries: expandMetricDataQueries(customizedLoadMetricFlat["metric_data_queries"].([]interface{})),
+	}
+	return customizedLoadMetric
+}
+
+func expandCustomizedScalingMetricSpecification(customizedScalingMetricSlice []interface{}) *autoscaling.PredictiveScalingCustomizedScalingMetric {
+	if customizedScalingMetricSlice == nil || len(customizedScalingMetricSlice) < 1 || customizedScalingMetricSlice[0] == nil {
+		return nil
+	}
+	customizedScalingMetricFlat := customizedScalingMetricSlice[0].(map[string]interface{})
+	customizedScalingMetric := &autoscaling.PredictiveScalingCustomizedScalingMetric{
+		MetricDataQueries: expandMetricDataQueries(customizedScalingMetricFlat["metric_data_queries"].([]interface{})),
+	}
+	return customizedScalingMetric
+}
+
+func expandMetricDataQueries(metricDataQuerySlices []interface{}) []*autoscaling.MetricDataQuery {
+	if metricDataQuerySlices == nil || len(metricDataQuerySlices) < 1 {
+		return nil
+	}
+	metricDataQueries := []*autoscaling.MetricDataQuery{}
+	for _, metricDataQuerySlice := range metricDataQuerySlices {
+		if metricDataQuerySlice == nil {
+			continue
+		}
+		metricDataQueryFlat := metricDataQuerySlice.(map[string]interface{})
+		metricDataQuery := &autoscaling.MetricDataQuery{
+			Id:         aws.String(metricDataQueryFlat["id"].(string)),
+			MetricStat: expandMetricStat(metricDataQueryFlat["metric_stat"].([]interface{})),
+			ReturnData: aws.Bool(metricDataQueryFlat["return_data"].(bool)),
+		}
+		if val, ok := metricDataQueryFlat["expression"]; ok && val.(string) != "" {
+			metricDataQuery.Expression = aws.String(val.(string))
+		}
+		if val, ok := metricDataQueryFlat["label"]; ok && val.(string) != "" {
+			metricDataQuery.Label = aws.String(val.(string))
+		}
+		metricDataQueries = append(metricDataQueries, metricDataQuery)
+	}
+	return metricDataQueries
+}
+
+func expandMetricStat(metricStatSlice []interface{}) *autoscaling.MetricStat {
+	if metricStatSlice == nil || len(metricStatSlice) < 1 || metricStatSlice[0] == nil {
+		return nil
+	}
+	metricStatFlat := metricStatSlice[0].(map[string]interface{})
+	metricStat := &autoscaling.MetricStat{
+		Metric: expandMetric(metricStatFlat["metric"].([]interface{})),
+		Stat:   aws.String(metricStatFlat["stat"].(string)),
+	}
+	if val, ok := metricStatFlat["unit"]; ok && val.(string) != "" {
+		metricStat.Unit = aws.String(val.(string))
+	}
+	return metricStat
+}
+
+func expandMetric(metricSlice []interface{}) *autoscaling.Metric {
+	if metricSlice == nil || len(metricSlice) < 1 || metricSlice[0] == nil {
+		return nil
+	}
+	metricFlat := metricSlice[0].(map[string]interface{})
+	metric := &autoscaling.Metric{
+		MetricName: aws.String(metricFlat["metric_name"].(string)),
+		Namespace:  aws.String(metricFlat["namespace"].(string)),
+	}
+	if dimensions := metricFlat["dimensions"].(*schema.Set).List(); len(dimensions) > 0 {
+		for _, dimension := range dimensions {
+			dimensionFlat := dimension.(map[string]interface{})
+			metric.Dimensions = append(metric.Dimensions, &autoscaling.MetricDimension{
+				Name:  aws.String(dimensionFlat["name"].(string)),
+				Value: aws.String(dimensionFlat["value"].(string)),
+			})
+		}
+	}
+	return metric
+}
+
 func expandPredefinedLoadMetricSpecification(predefinedLoadMetricSpecificationSlice []interface{}) *autoscaling.PredictiveScalingPredefinedLoadMetric {
 	if predefinedLoadMetricSpecificationSlice == nil || len(predefinedLoadMetricSpecificationSlice) < 1 {
 		return nil
@@ -752,6 +979,21 @@ func flattenPredictiveScalingMetricSpecifications(metricSpecification []*autosca
 	if metricSpecification[0].TargetValue != nil {
 		metricSpecificationFlat["target_value"] = aws.Float64Value(metricSpecification[0].TargetValue)
 	}
+	if metricSpecification[0].CustomizedCapacityMetricSpecification != nil {
+		metricSpecificationFlat["customized_capacity_metric_specification"] = []map[string]interface{}{{
+			"metric_data_queries": flattenMetricDataQueries(metricSpecification[0].CustomizedCapacityMetricSpecification.MetricDataQueries),
+		}}
+	}
+	if metricSpecification[0].CustomizedLoadMetricSpecification != nil {
+		metricSpecificationFlat["customized_load_metric_specification"] = []map[string]interface{}{{
+			"metric_data_queries": flattenMetricDataQueries(metricSpecification[0].CustomizedLoadMetricSpecification.MetricDataQueries),
+		}}
+	}
+	if metricSpecification[0].CustomizedScalingMetricSpecification != nil {
+		metricSpecificationFlat["customized_scaling_metric_specification"] = []map[string]interface{}{{
+			"metric_data_queries": flattenMetricDataQueries(metricSpecification[0].CustomizedScalingMetricSpecification.MetricDataQueries),
+		}}
+	}
 	if metricSpecification[0].PredefinedLoadMetricSpecification != nil {
 		metricSpecificationFlat["predefined_load_metric_specification"] = flattenPredefinedLoadMetricSpecification(metricSpecification[0].PredefinedLoadMetricSpecification)
 	}
@@ -793,3 +1035,51 @@ func flattenPredefinedMetricPairSpecification(predefinedMetricPairSpecification
 	predefinedMetricPairSpecificationFlat["resource_label"] = aws.StringValue(predefinedMetricPairSpecification.ResourceLabel)
 	return []map[string]interface{}{predefinedMetricPairSpecificationFlat}
 }
+
+func flattenMetricDataQueries(metricDataQueries []*autoscaling.MetricDataQuery) []interface{} {
+	metricDataQueriesSpec := make([]interface{}, 0, len(metricDataQueries))
+	for _, metricDataQuery := range metricDataQueries {
+		if metricDataQuery == nil {
+			continue
+		}
+		metricDataQueryFlat := map[string]interface{}{
+			"id":          aws.StringValue(metricDataQuery.Id),
+			"return_data": aws.BoolValue(metricDataQuery.ReturnData),
+		}
+		if metricDataQuery.Expression != nil {
+			metricDataQueryFlat["expression"] = aws.StringValue(metricDataQuery.Expression)
+		}
+		if metricDataQuery.Label != nil {
+			metricDataQueryFlat["label"] = aws.StringValue(metricDataQuery.Label)
+		}
+		if metricStat := metricDataQuery.MetricStat; metricStat != nil {
+			metricStatFlat := map[string]interface{}{
+				"stat": aws.StringValue(metricStat.Stat),
+			}
+			if metricStat.Unit != nil {
+				metricStatFlat["unit"] = aws.StringValue(metricStat.Unit)
+			}
+			if metric := metricStat.Metric; metric != nil {
+				metricFlat := map[string]interface{}{
+					"metric_name": aws.StringValue(metric.MetricName),
+					"namespace":   aws.StringValue(metric.Namespace),
+				}
+				dimensions := make([]interface{}, 0, len(metric.Dimensions))
+				for _, dimension := range metric.Dimensions {
+					if dimension == nil {
+						continue
+					}
+					dimensions = append(dimensions, map[string]interface{}{
+						"name":  aws.StringValue(dimension.Name),
+						"value": aws.StringValue(dimension.Value),
+					})
+				}
+				metricFlat["dimensions"] = dimensions
+				metricStatFlat["metric"] = []map[string]interface{}{metricFlat}
+			}
+			metricDataQueryFlat["metric_stat"] = []map[string]interface{}{metricStatFlat}
+		}
+		metricDataQueriesSpec = append(metricDataQueriesSpec, metricDataQueryFlat)
+	}
+	return metricDataQueriesSpec
+}
@@ -2,6 +2,7 @@ package autoscaling_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -233,6 +234,69 @@ func TestAccAutoScalingPolicy_predictiveScalingUpdated(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingPolicy_predictiveScalingCustomized(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	resourceName := "aws_autoscaling_policy.test"
+
+	name := sdkacctest.RandomWithPrefix("terraform-testacc-asp")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_predictiveScalingCustomized(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.mode", "ForecastOnly"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.target_value", "32"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_capacity_metric_specification.0.metric_data_queries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_capacity_metric_specification.0.metric_data_queries.0.id", "capacity_sum"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_capacity_metric_specification.0.metric_data_queries.0.metric_stat.0.metric.0.metric_name", "GroupInServiceInstances"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_capacity_metric_specification.0.metric_data_queries.0.metric_stat.0.metric.0.dimensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_capacity_metric_specification.0.metric_data_queries.0.metric_stat.0.stat", "Average"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_queries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_queries.0.metric_stat.0.stat", "Sum"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_scaling_metric_specification.0.metric_data_queries.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_scaling_metric_specification.0.metric_data_queries.0.return_data", "false"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_scaling_metric_specification.0.metric_data_queries.1.expression", "cpu_sum / 2"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_scaling_metric_specification.0.metric_data_queries.1.return_data", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingPolicy_predictiveScalingInvalid(t *testing.T) {
+	name := sdkacctest.RandomWithPrefix("terraform-testacc-asp")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_predictiveScalingWithTargetTracking(name),
+				ExpectError: regexp.MustCompile(`target_tracking_configuration is only supported for policy type TargetTrackingScaling`),
+			},
+			{
+				Config:      testAccPolicyConfig_predictiveScalingWithStepScaling(name),
+				ExpectError: regexp.MustCompile(`predictive_scaling_configuration is only supported for policy type PredictiveScaling`),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingPolicy_disappears(t *testing.T) {
 	var policy autoscaling.ScalingPolicy
 
@@ -590,6 +654,145 @@ resource "aws_autoscaling_policy" "test" {
 `, name))
 }
 
+func testAccPolicyConfig_predictiveScalingCustomized(name string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_base(name), fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%[1]s-policy_predictive"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  predictive_scaling_configuration {
+    metric_specification {
+      target_value = 32
+
+      customized_capacity_metric_specification {
+        metric_data_queries {
+          id = "capacity_sum"
+
+          metric_stat {
+            metric {
+              metric_name = "GroupInServiceInstances"
+              namespace   = "AWS/AutoScaling"
+
+              dimensions {
+                name  = "AutoScalingGroupName"
+                value = aws_autoscaling_group.test.name
+              }
+            }
+
+            stat = "Average"
+          }
+        }
+      }
+
+      customized_load_metric_specification {
+        metric_data_queries {
+          id = "load_sum"
+
+          metric_stat {
+            metric {
+              metric_name = "CPUUtilization"
+              namespace   = "AWS/EC2"
+
+              dimensions {
+                name  = "AutoScalingGroupName"
+                value = aws_autoscaling_group.test.name
+              }
+            }
+
+            stat = "Sum"
+          }
+        }
+      }
+
+      customized_scaling_metric_specification {
+        metric_data_queries {
+          id          = "cpu_sum"
+          return_data = false
+
+          metric_stat {
+            metric {
+              metric_name = "CPUUtilization"
+              namespace   = "AWS/EC2"
+
+              dimensions {
+                name  = "AutoScalingGroupName"
+                value = aws_autoscaling_group.test.name
+              }
+            }
+
+            stat = "Sum"
+          }
+        }
+
+        metric_data_queries {
+          id         = "scaling"
+          expression = "cpu_sum / 2"
+          label      = "Average CPU utilization"
+        }
+      }
+    }
+  }
+}
+`, name))
+}
+
+func testAccPolicyConfig_predictiveScalingWithTargetTracking(name string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_base(name), fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%[1]s-policy_predictive"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  predictive_scaling_configuration {
+    metric_specification {
+      target_value = 32
+
+      predefined_metric_pair_specification {
+        predefined_metric_type = "ASGCPUUtilization"
+        resource_label         = "testLabel"
+      }
+    }
+  }
+
+  target_tracking_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "ASGAverageCPUUtilization"
+    }
+
+    target_value = 40.0
+  }
+}
+`, name))
+}
+
+func testAccPolicyConfig_predictiveScalingWithStepScaling(name string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_base(name), fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%[1]s-policy_predictive"
+  policy_type            = "StepScaling"
+  adjustment_type        = "ChangeInCapacity"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  predictive_scaling_configuration {
+    metric_specification {
+      target_value = 32
+
+      predefined_metric_pair_specification {
+        predefined_metric_type = "ASGCPUUtilization"
+        resource_label         = "testLabel"
+      }
+    }
+  }
+
+  step_adjustment {
+    scaling_adjustment          = 1
+    metric_interval_lower_bound = 0.0
+  }
+}
+`, name))
+}
+
 func testAccPolicyConfig_predictiveScalingRemoved(name string) string {
 	return acctest.ConfigCompose(testAccPolicyConfig_base(name), fmt.Sprintf(`
 resource "aws_autoscaling_policy" "test" {
@@ -0,0 +1,496 @@
+package bedrock
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomModelCreate,
+		Read:   resourceCustomModelRead,
+		Delete: resourceCustomModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_model_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_model_identifier": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringLenBetween(1, 2048),
+				DiffSuppressFunc: suppressEquivalentBaseModelIdentifier,
+			},
+			"custom_model_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"custom_model_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"customization_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      bedrock.ModelCustomizationFineTuning,
+				ValidateFunc: validation.StringInSlice(bedrock.ModelCustomization_Values(), false),
+			},
+			"hyper_parameters": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexpS3URI, "must be an S3 URI"),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"training_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexpS3URI, "must be an S3 URI"),
+						},
+					},
+				},
+			},
+			"validation_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validator": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_uri": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringMatch(regexpS3URI, "must be an S3 URI"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCustomModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+
+	jobName := d.Get("job_name").(string)
+	input := &bedrock.CreateModelCustomizationJobInput{
+		BaseModelIdentifier: aws.String(d.Get("base_model_identifier").(string)),
+		ClientRequestToken:  aws.String(resource.UniqueId()),
+		CustomModelName:     aws.String(d.Get("custom_model_name").(string)),
+		HyperParameters:     flex.ExpandStringMap(d.Get("hyper_parameters").(map[string]interface{})),
+		JobName:             aws.String(jobName),
+		OutputDataConfig:    expandOutputDataConfig(d.Get("output_data_config").([]interface{})),
+		RoleArn:             aws.String(d.Get("role_arn").(string)),
+		TrainingDataConfig:  expandTrainingDataConfig(d.Get("training_data_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("custom_model_kms_key_id"); ok {
+		input.CustomModelKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("validation_data_config"); ok {
+		input.ValidationDataConfig = expandValidationDataConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Bedrock Model Customization Job: %s", input)
+	output, err := conn.CreateModelCustomizationJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Bedrock Model Customization Job (%s): %w", jobName, err)
+	}
+
+	jobARN := aws.StringValue(output.JobArn)
+
+	// The custom model only exists once the job completes. Track the job until then so that
+	// a failed or timed out wait taints the resource instead of losing the job.
+	d.SetId(jobARN)
+
+	job, err := waitModelCustomizationJobCompleted(conn, jobARN, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Bedrock Model Customization Job (%s) to complete: %w", jobARN, err)
+	}
+
+	d.SetId(aws.StringValue(job.OutputModelArn))
+
+	return resourceCustomModelRead(d, meta)
+}
+
+func resourceCustomModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+
+	if isModelCustomizationJobARN(d.Id()) {
+		job, err := FindModelCustomizationJobByID(conn, d.Id())
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] Bedrock Model Customization Job (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Bedrock Model Customization Job (%s): %w", d.Id(), err)
+		}
+
+		if aws.StringValue(job.Status) != bedrock.ModelCustomizationJobStatusCompleted || aws.StringValue(job.OutputModelArn) == "" {
+			d.Set("job_arn", job.JobArn)
+			d.Set("job_name", job.JobName)
+			d.Set("job_status", job.Status)
+
+			return nil
+		}
+
+		d.SetId(aws.StringValue(job.OutputModelArn))
+	}
+
+	model, err := FindCustomModelByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Custom Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Bedrock Custom Model (%s): %w", d.Id(), err)
+	}
+
+	jobARN := aws.StringValue(model.JobArn)
+	job, err := FindModelCustomizationJobByID(conn, jobARN)
+
+	if err != nil {
+		return fmt.Errorf("error reading Bedrock Model Customization Job (%s): %w", jobARN, err)
+	}
+
+	d.Set("arn", model.ModelArn)
+	d.Set("base_model_arn", model.BaseModelArn)
+	if _, ok := d.GetOk("base_model_identifier"); !ok {
+		d.Set("base_model_identifier", model.BaseModelArn)
+	}
+	d.Set("custom_model_kms_key_id", model.ModelKmsKeyArn)
+	d.Set("custom_model_name", model.ModelName)
+	d.Set("customization_type", bedrock.ModelCustomizationFineTuning)
+	d.Set("hyper_parameters", aws.StringValueMap(model.HyperParameters))
+	d.Set("job_arn", jobARN)
+	d.Set("job_name", model.JobName)
+	d.Set("job_status", job.Status)
+
+	if err := d.Set("output_data_config", flattenOutputDataConfig(model.OutputDataConfig)); err != nil {
+		return fmt.Errorf("error setting output_data_config: %w", err)
+	}
+
+	d.Set("role_arn", job.RoleArn)
+
+	if err := d.Set("training_data_config", flattenTrainingDataConfig(model.TrainingDataConfig)); err != nil {
+		return fmt.Errorf("error setting training_data_config: %w", err)
+	}
+
+	if err := d.Set("validation_data_config", flattenValidationDataConfig(model.ValidationDataConfig)); err != nil {
+		return fmt.Errorf("error setting validation_data_config: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCustomModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+
+	modelID := d.Id()
+
+	if isModelCustomizationJobARN(d.Id()) {
+		job, err := FindModelCustomizationJobByID(conn, d.Id())
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Bedrock Model Customization Job (%s): %w", d.Id(), err)
+		}
+
+		if status := aws.StringValue(job.Status); status == bedrock.ModelCustomizationJobStatusInProgress || status == bedrock.ModelCustomizationJobStatusStopping {
+			if status == bedrock.ModelCustomizationJobStatusInProgress {
+				log.Printf("[DEBUG] Stopping Bedrock Model Customization Job: %s", d.Id())
+				_, err := conn.StopModelCustomizationJob(&bedrock.StopModelCustomizationJobInput{
+					JobIdentifier: aws.String(d.Id()),
+				})
+
+				if err != nil {
+					return fmt.Errorf("error stopping Bedrock Model Customization Job (%s): %w", d.Id(), err)
+				}
+			}
+
+			if job, err = waitModelCustomizationJobStopped(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+				return fmt.Errorf("error waiting for Bedrock Model Customization Job (%s) to stop: %w", d.Id(), err)
+			}
+		}
+
+		// The job may have completed before it could be stopped.
+		modelID = aws.StringValue(job.OutputModelArn)
+
+		if aws.StringValue(job.Status) != bedrock.ModelCustomizationJobStatusCompleted || modelID == "" {
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Bedrock Custom Model: %s", modelID)
+	_, err := conn.DeleteCustomModel(&bedrock.DeleteCustomModelInput{
+		ModelIdentifier: aws.String(modelID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Bedrock Custom Model (%s): %w", modelID, err)
+	}
+
+	return nil
+}
+
+// isModelCustomizationJobARN returns whether id is the ARN of a model customization job.
+// The resource is identified by its job until the job has created the custom model.
+func isModelCustomizationJobARN(id string) bool {
+	parsedARN, err := arn.Parse(id)
+
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(parsedARN.Resource, "model-customization-job/")
+}
+
+// suppressEquivalentBaseModelIdentifier suppresses differences between a
+// foundation model ARN, as returned by the API, and the model ID it ends with.
+func suppressEquivalentBaseModelIdentifier(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	parsedARN, err := arn.Parse(old)
+
+	if err != nil {
+		return false
+	}
+
+	modelID := strings.TrimPrefix(parsedARN.Resource, "foundation-model/")
+
+	return modelID == new || strings.HasPrefix(modelID, new+":")
+}
+
+func expandOutputDataConfig(tfList []interface{}) *bedrock.OutputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &bedrock.OutputDataConfig{}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTrainingDataConfig(tfList []interface{}) *bedrock.TrainingDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &bedrock.TrainingDataConfig{}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandValidationDataConfig(tfList []interface{}) *bedrock.ValidationDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &bedrock.ValidationDataConfig{}
+
+	if v, ok := tfMap["validator"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Validators = expandValidators(v)
+	}
+
+	return apiObject
+}
+
+func expandValidators(tfList []interface{}) []*bedrock.Validator {
+	var apiObjects []*bedrock.Validator
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &bedrock.Validator{}
+
+		if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+			apiObject.S3Uri = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenOutputDataConfig(apiObject *bedrock.OutputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Uri; v != nil {
+		tfMap["s3_uri"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrainingDataConfig(apiObject *bedrock.TrainingDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Uri; v != nil {
+		tfMap["s3_uri"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenValidationDataConfig(apiObject *bedrock.ValidationDataConfig) []interface{} {
+	if apiObject == nil || len(apiObject.Validators) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"validator": flattenValidators(apiObject.Validators),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenValidators(apiObjects []*bedrock.Validator) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.S3Uri; v != nil {
+			tfMap["s3_uri"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,193 @@
+package bedrock_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockCustomModel_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v bedrock.GetCustomModelOutput
+	resourceName := "aws_bedrock_custom_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(bedrock.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, bedrock.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomModelExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "bedrock", regexp.MustCompile(`custom-model/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "base_model_arn"),
+					resource.TestCheckResourceAttr(resourceName, "base_model_identifier", "amazon.titan-text-express-v1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "customization_type", bedrock.ModelCustomizationFineTuning),
+					resource.TestCheckResourceAttr(resourceName, "hyper_parameters.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "hyper_parameters.epochCount", "1"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "job_arn", "bedrock", regexp.MustCompile(`model-customization-job/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_status", bedrock.ModelCustomizationJobStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.0.s3_uri", fmt.Sprintf("s3://%s/data/", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_config.0.s3_uri", fmt.Sprintf("s3://%s/data/train.jsonl", rName)),
+					resource.TestCheckResourceAttr(resourceName, "validation_data_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API returns the base model ARN rather than the configured model ID.
+				ImportStateVerifyIgnore: []string{"base_model_identifier"},
+			},
+		},
+	})
+}
+
+func testAccCheckCustomModelExists(n string, v *bedrock.GetCustomModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Custom Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockConn
+
+		output, err := tfbedrock.FindCustomModelByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCustomModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_bedrock_custom_model" {
+			continue
+		}
+
+		_, err := tfbedrock.FindCustomModelByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Bedrock Custom Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCustomModelConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "training" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "data/train.jsonl"
+  content = <<EOT
+{"prompt": "What is the capital of France?", "completion": "Paris"}
+{"prompt": "What is the capital of Germany?", "completion": "Berlin"}
+{"prompt": "What is the capital of Italy?", "completion": "Rome"}
+EOT
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "bedrock.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:PutObject",
+        "s3:ListBucket",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_bedrock_custom_model" "test" {
+  base_model_identifier = "amazon.titan-text-express-v1"
+  custom_model_name     = %[1]q
+  job_name              = %[1]q
+  role_arn              = aws_iam_role.test.arn
+
+  hyper_parameters = {
+    "epochCount"              = "1"
+    "batchSize"               = "1"
+    "learningRate"            = "0.005"
+    "learningRateWarmupSteps" = "0"
+  }
+
+  output_data_config {
+    s3_uri = "s3://${aws_s3_bucket.test.id}/data/"
+  }
+
+  training_data_config {
+    s3_uri = "s3://${aws_s3_object.training.bucket}/${aws_s3_object.training.key}"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
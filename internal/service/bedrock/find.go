@@ -0,0 +1,59 @@
+package bedrock
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCustomModelByID(conn *bedrock.Bedrock, id string) (*bedrock.GetCustomModelOutput, error) {
+	input := &bedrock.GetCustomModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCustomModel(input)
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindModelCustomizationJobByID(conn *bedrock.Bedrock, id string) (*bedrock.GetModelCustomizationJobOutput, error) {
+	input := &bedrock.GetModelCustomizationJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetModelCustomizationJob(input)
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
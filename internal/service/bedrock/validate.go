@@ -0,0 +1,7 @@
+package bedrock
+
+import (
+	"regexp"
+)
+
+var regexpS3URI = regexp.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`)
@@ -0,0 +1,51 @@
+package bedrock
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitModelCustomizationJobCompleted(conn *bedrock.Bedrock, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrock.ModelCustomizationJobStatusInProgress},
+		Target:  []string{bedrock.ModelCustomizationJobStatusCompleted},
+		Refresh: statusModelCustomizationJob(conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		if v := aws.StringValue(output.FailureMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelCustomizationJobStopped(conn *bedrock.Bedrock, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrock.ModelCustomizationJobStatusInProgress, bedrock.ModelCustomizationJobStatusStopping},
+		Target:  []string{bedrock.ModelCustomizationJobStatusStopped, bedrock.ModelCustomizationJobStatusCompleted, bedrock.ModelCustomizationJobStatusFailed},
+		Refresh: statusModelCustomizationJob(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
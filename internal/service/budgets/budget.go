@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -42,6 +43,43 @@ func ResourceBudget() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_adjust_data": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"auto_adjust_data", "limit_amount"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_adjust_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(budgets.AutoAdjustType_Values(), false),
+						},
+						"historical_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"budget_adjustment_period": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"lookback_available_periods": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"last_auto_adjust_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"budget_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -142,12 +180,18 @@ func ResourceBudget() *schema.Resource {
 			},
 			"limit_amount": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				DiffSuppressFunc: suppressEquivalentBudgetLimitAmount,
+				ExactlyOneOf:     []string{"auto_adjust_data", "limit_amount"},
+				RequiredWith:     []string{"limit_unit"},
 			},
 			"limit_unit": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"auto_adjust_data"},
+				RequiredWith:  []string{"limit_amount"},
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -292,6 +336,11 @@ func resourceBudgetRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  fmt.Sprintf("budget/%s", budgetName),
 	}
 	d.Set("arn", arn.String())
+
+	if err := d.Set("auto_adjust_data", flattenBudgetsAutoAdjustData(budget.AutoAdjustData)); err != nil {
+		return fmt.Errorf("error setting auto_adjust_data: %w", err)
+	}
+
 	d.Set("budget_type", budget.BudgetType)
 
 	// `cost_filters` should be removed in future releases
@@ -525,6 +574,30 @@ func flattenBudgetsCostTypes(costTypes *budgets.CostTypes) []map[string]interfac
 	return []map[string]interface{}{m}
 }
 
+func flattenBudgetsAutoAdjustData(autoAdjustData *budgets.AutoAdjustData) []interface{} {
+	if autoAdjustData == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"auto_adjust_type":      aws.StringValue(autoAdjustData.AutoAdjustType),
+		"last_auto_adjust_time": "",
+	}
+
+	if v := autoAdjustData.HistoricalOptions; v != nil {
+		tfMap["historical_options"] = []interface{}{map[string]interface{}{
+			"budget_adjustment_period":   aws.Int64Value(v.BudgetAdjustmentPeriod),
+			"lookback_available_periods": aws.Int64Value(v.LookBackAvailablePeriods),
+		}}
+	}
+
+	if v := autoAdjustData.LastAutoAdjustTime; v != nil {
+		tfMap["last_auto_adjust_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func convertCostFiltersToMap(costFilters map[string][]*string) []map[string]interface{} {
 	convertedCostFilters := make([]map[string]interface{}, 0)
 	for k, v := range costFilters {
@@ -558,8 +631,6 @@ func convertCostFiltersToStringMap(costFilters map[string][]*string) map[string]
 func expandBudgetsBudgetUnmarshal(d *schema.ResourceData) (*budgets.Budget, error) {
 	budgetName := d.Get("name").(string)
 	budgetType := d.Get("budget_type").(string)
-	budgetLimitAmount := d.Get("limit_amount").(string)
-	budgetLimitUnit := d.Get("limit_unit").(string)
 	budgetTimeUnit := d.Get("time_unit").(string)
 	budgetCostFilters := make(map[string][]*string)
 
@@ -593,10 +664,6 @@ func expandBudgetsBudgetUnmarshal(d *schema.ResourceData) (*budgets.Budget, erro
 	budget := &budgets.Budget{
 		BudgetName: aws.String(budgetName),
 		BudgetType: aws.String(budgetType),
-		BudgetLimit: &budgets.Spend{
-			Amount: aws.String(budgetLimitAmount),
-			Unit:   aws.String(budgetLimitUnit),
-		},
 		TimePeriod: &budgets.TimePeriod{
 			End:   budgetTimePeriodEnd,
 			Start: budgetTimePeriodStart,
@@ -609,9 +676,41 @@ func expandBudgetsBudgetUnmarshal(d *schema.ResourceData) (*budgets.Budget, erro
 		budget.CostTypes = expandBudgetsCostTypes(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	// An auto-adjusting budget has its limit calculated by AWS, so the limit is only sent otherwise.
+	if v, ok := d.GetOk("auto_adjust_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		budget.AutoAdjustData = expandBudgetsAutoAdjustData(v.([]interface{})[0].(map[string]interface{}))
+	} else if v, ok := d.GetOk("limit_amount"); ok {
+		budget.BudgetLimit = &budgets.Spend{
+			Amount: aws.String(v.(string)),
+			Unit:   aws.String(d.Get("limit_unit").(string)),
+		}
+	}
+
 	return budget, nil
 }
 
+func expandBudgetsAutoAdjustData(tfMap map[string]interface{}) *budgets.AutoAdjustData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &budgets.AutoAdjustData{}
+
+	if v, ok := tfMap["auto_adjust_type"].(string); ok && v != "" {
+		apiObject.AutoAdjustType = aws.String(v)
+	}
+
+	if v, ok := tfMap["historical_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.HistoricalOptions = &budgets.HistoricalOptions{
+			BudgetAdjustmentPeriod: aws.Int64(int64(tfMap["budget_adjustment_period"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
 func expandBudgetsCostTypes(tfMap map[string]interface{}) *budgets.CostTypes {
 	if tfMap == nil {
 		return nil
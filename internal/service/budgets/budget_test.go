@@ -2,6 +2,7 @@ package budgets_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -344,6 +345,117 @@ func TestAccBudgetsBudget_notifications(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudget_autoAdjustDataHistorical(t *testing.T) {
+	var budget budgets.Budget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, budgets.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBudgetAutoAdjustDataHistoricalConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.auto_adjust_type", "HISTORICAL"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.historical_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.historical_options.0.budget_adjustment_period", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "auto_adjust_data.0.historical_options.0.lookback_available_periods"),
+					resource.TestCheckResourceAttr(resourceName, "budget_type", "COST"),
+					resource.TestCheckResourceAttrSet(resourceName, "limit_amount"),
+					resource.TestCheckResourceAttrSet(resourceName, "limit_unit"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "MONTHLY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBudgetAutoAdjustDataHistoricalConfig(rName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.auto_adjust_type", "HISTORICAL"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.historical_options.0.budget_adjustment_period", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBudgetsBudget_autoAdjustDataForecast(t *testing.T) {
+	var budget budgets.Budget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, budgets.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBudgetAutoAdjustDataForecastConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.auto_adjust_type", "FORECAST"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.historical_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "budget_type", "COST"),
+					resource.TestCheckResourceAttrSet(resourceName, "limit_amount"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBudgetsBudget_Validation_noLimit(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, budgets.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBudgetNoLimitConfig(rName),
+				ExpectError: regexp.MustCompile(`one of .auto_adjust_data,limit_amount. must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccBudgetsBudget_Validation_limitAndAutoAdjustData(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, budgets.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBudgetLimitAndAutoAdjustDataConfig(rName),
+				ExpectError: regexp.MustCompile(`only one of .auto_adjust_data,limit_amount. can be specified`),
+			},
+		},
+	})
+}
+
 func testAccBudgetExists(resourceName string, v *budgets.Budget) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -449,6 +561,64 @@ resource "aws_budgets_budget" "test" {
 `, namePrefix)
 }
 
+func testAccBudgetAutoAdjustDataHistoricalConfig(rName string, budgetAdjustmentPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name        = %[1]q
+  budget_type = "COST"
+  time_unit   = "MONTHLY"
+
+  auto_adjust_data {
+    auto_adjust_type = "HISTORICAL"
+
+    historical_options {
+      budget_adjustment_period = %[2]d
+    }
+  }
+}
+`, rName, budgetAdjustmentPeriod)
+}
+
+func testAccBudgetAutoAdjustDataForecastConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name        = %[1]q
+  budget_type = "COST"
+  time_unit   = "MONTHLY"
+
+  auto_adjust_data {
+    auto_adjust_type = "FORECAST"
+  }
+}
+`, rName)
+}
+
+func testAccBudgetNoLimitConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name        = %[1]q
+  budget_type = "COST"
+  time_unit   = "MONTHLY"
+}
+`, rName)
+}
+
+func testAccBudgetLimitAndAutoAdjustDataConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name         = %[1]q
+  budget_type  = "COST"
+  limit_amount = "100"
+  limit_unit   = "USD"
+  time_unit    = "MONTHLY"
+
+  auto_adjust_data {
+    auto_adjust_type = "FORECAST"
+  }
+}
+`, rName)
+}
+
 func testAccBudgetCostTypesConfig(rName, startDate, endDate string) string {
 	return fmt.Sprintf(`
 resource "aws_budgets_budget" "test" {
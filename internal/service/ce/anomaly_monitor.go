@@ -0,0 +1,214 @@
+package ce
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnomalyMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAnomalyMonitorCreate,
+		Read:   resourceAnomalyMonitorRead,
+		Update: resourceAnomalyMonitorUpdate,
+		Delete: resourceAnomalyMonitorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_dimension": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"monitor_specification"},
+				ValidateFunc:  validation.StringInSlice(costexplorer.MonitorDimension_Values(), false),
+			},
+			"monitor_specification": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"monitor_dimension"},
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				ValidateFunc:     validation.StringIsJSON,
+			},
+			"monitor_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.MonitorType_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAnomalyMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	monitor := &costexplorer.AnomalyMonitor{
+		MonitorName: aws.String(name),
+		MonitorType: aws.String(d.Get("monitor_type").(string)),
+	}
+
+	if v, ok := d.GetOk("monitor_dimension"); ok {
+		monitor.MonitorDimension = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("monitor_specification"); ok {
+		expression := &costexplorer.Expression{}
+
+		if err := jsonutil.UnmarshalJSON(expression, bytes.NewReader([]byte(v.(string)))); err != nil {
+			return fmt.Errorf("error parsing monitor_specification: %w", err)
+		}
+
+		monitor.MonitorSpecification = expression
+	}
+
+	input := &costexplorer.CreateAnomalyMonitorInput{
+		AnomalyMonitor: monitor,
+	}
+
+	if len(tags) > 0 {
+		input.ResourceTags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Anomaly Monitor: %s", input)
+	output, err := conn.CreateAnomalyMonitor(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Cost Explorer Anomaly Monitor (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.MonitorArn))
+
+	return resourceAnomalyMonitorRead(d, meta)
+}
+
+func resourceAnomalyMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	monitor, err := FindAnomalyMonitorByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Anomaly Monitor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cost Explorer Anomaly Monitor (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", monitor.MonitorArn)
+	d.Set("monitor_dimension", monitor.MonitorDimension)
+	d.Set("monitor_type", monitor.MonitorType)
+	d.Set("name", monitor.MonitorName)
+
+	if monitor.MonitorSpecification != nil {
+		b, err := jsonutil.BuildJSON(monitor.MonitorSpecification)
+
+		if err != nil {
+			return fmt.Errorf("error serializing monitor_specification: %w", err)
+		}
+
+		d.Set("monitor_specification", string(b))
+	} else {
+		d.Set("monitor_specification", nil)
+	}
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Cost Explorer Anomaly Monitor (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAnomalyMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	if d.HasChange("name") {
+		input := &costexplorer.UpdateAnomalyMonitorInput{
+			MonitorArn:  aws.String(d.Id()),
+			MonitorName: aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Cost Explorer Anomaly Monitor: %s", input)
+		_, err := conn.UpdateAnomalyMonitor(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Cost Explorer Anomaly Monitor (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Cost Explorer Anomaly Monitor (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAnomalyMonitorRead(d, meta)
+}
+
+func resourceAnomalyMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Anomaly Monitor: %s", d.Id())
+	_, err := conn.DeleteAnomalyMonitor(&costexplorer.DeleteAnomalyMonitorInput{
+		MonitorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cost Explorer Anomaly Monitor (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package ce_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCEAnomalyMonitor_basic(t *testing.T) {
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalymonitor/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "monitor_dimension", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "monitor_specification"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", costexplorer.MonitorTypeCustom),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyMonitorConfig(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfce.ResourceAnomalyMonitor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// Only one DIMENSIONAL monitor of each dimension is allowed per account.
+func TestAccCEAnomalyMonitor_dimensional(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorDimensionalConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor_dimension", costexplorer.MonitorDimensionService),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", costexplorer.MonitorTypeDimensional),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyMonitorTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAnomalyMonitorTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalyMonitorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Anomaly Monitor ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+		_, err := tfce.FindAnomalyMonitorByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAnomalyMonitorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_monitor" {
+			continue
+		}
+
+		_, err := tfce.FindAnomalyMonitorByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Anomaly Monitor %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAnomalyMonitorConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    Tags = {
+      Key          = "CostCenter"
+      Values       = ["10000"]
+      MatchOptions = ["EQUALS"]
+    }
+  })
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorDimensionalConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name              = %[1]q
+  monitor_type      = "DIMENSIONAL"
+  monitor_dimension = "SERVICE"
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    Tags = {
+      Key          = "CostCenter"
+      Values       = ["10000"]
+      MatchOptions = ["EQUALS"]
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAnomalyMonitorTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    Tags = {
+      Key          = "CostCenter"
+      Values       = ["10000"]
+      MatchOptions = ["EQUALS"]
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,323 @@
+package ce_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCEAnomalySubscription_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionThresholdExpressionConfig(rName, address, costexplorer.DimensionAnomalyTotalImpactAbsolute, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalysubscription/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "frequency", costexplorer.AnomalySubscriptionFrequencyDaily),
+					resource.TestCheckResourceAttr(resourceName, "monitor_arn_list.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "monitor_arn_list.*", "aws_ce_anomaly_monitor.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"address": address,
+						"type":    costexplorer.SubscriberTypeEmail,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionThresholdExpressionConfig(rName, address, costexplorer.DimensionAnomalyTotalImpactAbsolute, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfce.ResourceAnomalySubscription(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_thresholdExpressionAbsolute(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionThresholdExpressionConfig(rName, address, costexplorer.DimensionAnomalyTotalImpactAbsolute, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.key", costexplorer.DimensionAnomalyTotalImpactAbsolute),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.match_options.*", costexplorer.MatchOptionGreaterThanOrEqual),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.values.*", "100"),
+				),
+			},
+			{
+				Config: testAccAnomalySubscriptionThresholdExpressionConfig(rName, address, costexplorer.DimensionAnomalyTotalImpactAbsolute, "200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.values.*", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_thresholdExpressionPercentage(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionThresholdExpressionConfig(rName, address, costexplorer.DimensionAnomalyTotalImpactPercentage, "20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.key", costexplorer.DimensionAnomalyTotalImpactPercentage),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.values.*", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_thresholdExpressionAnd(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionThresholdExpressionAndConfig(rName, address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Subscriptions created with the deprecated threshold argument can be moved to threshold_expression.
+func TestAccCEAnomalySubscription_thresholdMigration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionThresholdConfig(rName, address, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "100"),
+				),
+			},
+			{
+				Config: testAccAnomalySubscriptionThresholdExpressionConfig(rName, address, costexplorer.DimensionAnomalyTotalImpactAbsolute, "150"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.key", costexplorer.DimensionAnomalyTotalImpactAbsolute),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.values.*", "150"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalySubscriptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Anomaly Subscription ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+		_, err := tfce.FindAnomalySubscriptionByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAnomalySubscriptionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_subscription" {
+			continue
+		}
+
+		_, err := tfce.FindAnomalySubscriptionByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Anomaly Subscription %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAnomalySubscriptionBaseConfig(rName string) string {
+	return testAccAnomalyMonitorConfig(rName)
+}
+
+func testAccAnomalySubscriptionThresholdConfig(rName, address string, threshold int) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = "DAILY"
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+  threshold        = %[3]d
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+}
+`, rName, address, threshold))
+}
+
+func testAccAnomalySubscriptionThresholdExpressionConfig(rName, address, key, value string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = "DAILY"
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = %[3]q
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+      values        = [%[4]q]
+    }
+  }
+}
+`, rName, address, key, value))
+}
+
+func testAccAnomalySubscriptionThresholdExpressionAndConfig(rName, address string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = "DAILY"
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+        values        = ["100"]
+      }
+    }
+
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_PERCENTAGE"
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+        values        = ["50"]
+      }
+    }
+  }
+}
+`, rName, address))
+}
@@ -0,0 +1,254 @@
+package ce
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// expressionSchema returns the schema of a Cost Explorer Expression.
+// The and, or and not operators may be nested one level deep.
+func expressionSchema() map[string]*schema.Schema {
+	m := expressionLeafSchema()
+
+	m["and"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: expressionLeafSchema(),
+		},
+	}
+	m["not"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: expressionLeafSchema(),
+		},
+	}
+	m["or"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: expressionLeafSchema(),
+		},
+	}
+
+	return m
+}
+
+func expressionLeafSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cost_category": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: expressionValuesSchema(validation.StringLenBetween(1, 50)),
+			},
+		},
+		"dimension": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: expressionValuesSchema(validation.StringInSlice(costexplorer.Dimension_Values(), false)),
+			},
+		},
+		"tags": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: expressionValuesSchema(validation.StringLenBetween(1, 1024)),
+			},
+		},
+	}
+}
+
+func expressionValuesSchema(keyValidateFunc schema.SchemaValidateFunc) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: keyValidateFunc,
+		},
+		"match_options": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(costexplorer.MatchOption_Values(), false),
+			},
+		},
+		"values": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func expandExpression(tfMap map[string]interface{}) *costexplorer.Expression {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.Expression{}
+
+	if v, ok := tfMap["and"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.And = expandExpressions(v.List())
+	}
+
+	if v, ok := tfMap["cost_category"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CostCategories = expandCostCategoryValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dimension"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Dimensions = expandDimensionValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["not"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Not = expandExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["or"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Or = expandExpressions(v.List())
+	}
+
+	if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Tags = expandTagValues(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandExpressions(tfList []interface{}) []*costexplorer.Expression {
+	var apiObjects []*costexplorer.Expression
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandExpression(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandCostCategoryValues(tfMap map[string]interface{}) *costexplorer.CostCategoryValues {
+	apiObject := &costexplorer.CostCategoryValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandDimensionValues(tfMap map[string]interface{}) *costexplorer.DimensionValues {
+	apiObject := &costexplorer.DimensionValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandTagValues(tfMap map[string]interface{}) *costexplorer.TagValues {
+	apiObject := &costexplorer.TagValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenExpression(apiObject *costexplorer.Expression) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = flattenExpressions(v)
+	}
+
+	if v := apiObject.CostCategories; v != nil {
+		tfMap["cost_category"] = []interface{}{flattenValues(v.Key, v.MatchOptions, v.Values)}
+	}
+
+	if v := apiObject.Dimensions; v != nil {
+		tfMap["dimension"] = []interface{}{flattenValues(v.Key, v.MatchOptions, v.Values)}
+	}
+
+	if v := apiObject.Not; v != nil {
+		tfMap["not"] = []interface{}{flattenExpression(v)}
+	}
+
+	if v := apiObject.Or; v != nil {
+		tfMap["or"] = flattenExpressions(v)
+	}
+
+	if v := apiObject.Tags; v != nil {
+		tfMap["tags"] = []interface{}{flattenValues(v.Key, v.MatchOptions, v.Values)}
+	}
+
+	return tfMap
+}
+
+func flattenExpressions(apiObjects []*costexplorer.Expression) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenExpression(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenValues(key *string, matchOptions, values []*string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"key":           aws.StringValue(key),
+		"match_options": aws.StringValueSlice(matchOptions),
+		"values":        aws.StringValueSlice(values),
+	}
+
+	return tfMap
+}
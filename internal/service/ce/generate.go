@@ -0,0 +1,4 @@
+//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOutTagsElem=ResourceTags -ServiceTagsSlice -TagInTagsElem=ResourceTags -TagType=ResourceTag -UntagInTagsElem=ResourceTagKeys -UpdateTags
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package ce
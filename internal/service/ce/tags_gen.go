@@ -0,0 +1,92 @@
+// Code generated by internal/generate/tags/main.go; DO NOT EDIT.
+package ce
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ListTags lists ce service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ListTags(conn *costexplorer.CostExplorer, identifier string) (tftags.KeyValueTags, error) {
+	input := &costexplorer.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.ResourceTags), nil
+}
+
+// []*SERVICE.Tag handling
+
+// Tags returns ce service tags.
+func Tags(tags tftags.KeyValueTags) []*costexplorer.ResourceTag {
+	result := make([]*costexplorer.ResourceTag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &costexplorer.ResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// KeyValueTags creates tftags.KeyValueTags from costexplorer service tags.
+func KeyValueTags(tags []*costexplorer.ResourceTag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
+
+// UpdateTags updates ce service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func UpdateTags(conn *costexplorer.CostExplorer, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &costexplorer.UntagResourceInput{
+			ResourceArn:     aws.String(identifier),
+			ResourceTagKeys: aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &costexplorer.TagResourceInput{
+			ResourceArn:  aws.String(identifier),
+			ResourceTags: Tags(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,460 @@
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCollaboration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCollaborationCreate,
+		Read:   resourceCollaborationRead,
+		Update: resourceCollaborationUpdate,
+		Delete: resourceCollaborationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffCollaborationMembers,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"creator_member_abilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cleanrooms.MemberAbility_Values(), false),
+				},
+			},
+			"data_encryption_metadata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_clear_text": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_duplicates": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_joins_on_columns_with_different_names": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"preserve_nulls": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"member": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"member_abilities": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(cleanrooms.MemberAbility_Values(), false),
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_log_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.CollaborationQueryLogStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCollaborationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateCollaborationInput{
+		CreatorDisplayName:     aws.String(d.Get("creator_display_name").(string)),
+		CreatorMemberAbilities: flex.ExpandStringSet(d.Get("creator_member_abilities").(*schema.Set)),
+		Description:            aws.String(d.Get("description").(string)),
+		Members:                expandMemberSpecifications(d.Get("member").([]interface{})),
+		Name:                   aws.String(name),
+		QueryLogStatus:         aws.String(d.Get("query_log_status").(string)),
+	}
+
+	if v, ok := d.GetOk("data_encryption_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataEncryptionMetadata = expandDataEncryptionMetadata(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Collaboration: %s", input)
+	output, err := conn.CreateCollaboration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Clean Rooms Collaboration (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Collaboration.Id))
+
+	return resourceCollaborationRead(d, meta)
+}
+
+func resourceCollaborationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	collaboration, err := FindCollaborationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Collaboration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Clean Rooms Collaboration (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(collaboration.Arn)
+	d.Set("arn", arn)
+	d.Set("create_time", aws.TimeValue(collaboration.CreateTime).Format(time.RFC3339))
+	d.Set("creator_display_name", collaboration.CreatorDisplayName)
+	if err := d.Set("data_encryption_metadata", flattenDataEncryptionMetadata(collaboration.DataEncryptionMetadata)); err != nil {
+		return fmt.Errorf("error setting data_encryption_metadata: %w", err)
+	}
+	d.Set("description", collaboration.Description)
+	d.Set("name", collaboration.Name)
+	d.Set("query_log_status", collaboration.QueryLogStatus)
+	d.Set("update_time", aws.TimeValue(collaboration.UpdateTime).Format(time.RFC3339))
+
+	members, err := FindMembersByCollaborationID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing Clean Rooms Collaboration (%s) members: %w", d.Id(), err)
+	}
+
+	// The collaboration creator is returned as a member alongside those configured in member blocks.
+	// Members removed from the collaboration continue to be listed with status REMOVED.
+	creatorAccountID := aws.StringValue(collaboration.CreatorAccountId)
+	var otherMembers []*cleanrooms.MemberSummary
+
+	for _, member := range members {
+		if aws.StringValue(member.Status) == cleanrooms.MemberStatusRemoved {
+			continue
+		}
+
+		if aws.StringValue(member.AccountId) == creatorAccountID {
+			d.Set("creator_member_abilities", aws.StringValueSlice(member.Abilities))
+			continue
+		}
+
+		otherMembers = append(otherMembers, member)
+	}
+
+	if err := d.Set("member", flattenMemberSummaries(otherMembers, d.Get("member").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting member: %w", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Clean Rooms Collaboration (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCollaborationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChanges("description", "name") {
+		input := &cleanrooms.UpdateCollaborationInput{
+			CollaborationIdentifier: aws.String(d.Id()),
+			Description:             aws.String(d.Get("description").(string)),
+			Name:                    aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Collaboration: %s", input)
+		_, err := conn.UpdateCollaboration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Clean Rooms Collaboration (%s): %w", d.Id(), err)
+		}
+	}
+
+	// Members can only be removed from an existing collaboration, additions force replacement.
+	if d.HasChange("member") {
+		o, n := d.GetChange("member")
+
+		for accountID := range memberAccountIDs(o.([]interface{})) {
+			if _, ok := memberAccountIDs(n.([]interface{}))[accountID]; ok {
+				continue
+			}
+
+			log.Printf("[DEBUG] Removing member (%s) from Clean Rooms Collaboration (%s)", accountID, d.Id())
+			_, err := conn.DeleteMember(&cleanrooms.DeleteMemberInput{
+				AccountId:               aws.String(accountID),
+				CollaborationIdentifier: aws.String(d.Id()),
+			})
+
+			if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error removing member (%s) from Clean Rooms Collaboration (%s): %w", accountID, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Clean Rooms Collaboration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCollaborationRead(d, meta)
+}
+
+func resourceCollaborationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Collaboration: %s", d.Id())
+	_, err := conn.DeleteCollaboration(&cleanrooms.DeleteCollaborationInput{
+		CollaborationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Clean Rooms Collaboration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// customizeDiffCollaborationMembers forces replacement when a member is added
+// or an existing member is changed, as the API only supports removing members.
+func customizeDiffCollaborationMembers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("member") {
+		return nil
+	}
+
+	o, n := diff.GetChange("member")
+	oldMembers := memberAccountIDs(o.([]interface{}))
+
+	for accountID, newMember := range memberAccountIDs(n.([]interface{})) {
+		oldMember, ok := oldMembers[accountID]
+
+		if !ok || oldMember["display_name"].(string) != newMember["display_name"].(string) || !oldMember["member_abilities"].(*schema.Set).Equal(newMember["member_abilities"].(*schema.Set)) {
+			return diff.ForceNew("member")
+		}
+	}
+
+	return nil
+}
+
+func memberAccountIDs(tfList []interface{}) map[string]map[string]interface{} {
+	m := make(map[string]map[string]interface{}, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		m[tfMap["account_id"].(string)] = tfMap
+	}
+
+	return m
+}
+
+func expandDataEncryptionMetadata(tfMap map[string]interface{}) *cleanrooms.DataEncryptionMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &cleanrooms.DataEncryptionMetadata{
+		AllowCleartext:                        aws.Bool(tfMap["allow_clear_text"].(bool)),
+		AllowDuplicates:                       aws.Bool(tfMap["allow_duplicates"].(bool)),
+		AllowJoinsOnColumnsWithDifferentNames: aws.Bool(tfMap["allow_joins_on_columns_with_different_names"].(bool)),
+		PreserveNulls:                         aws.Bool(tfMap["preserve_nulls"].(bool)),
+	}
+}
+
+func expandMemberSpecifications(tfList []interface{}) []*cleanrooms.MemberSpecification {
+	apiObjects := []*cleanrooms.MemberSpecification{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &cleanrooms.MemberSpecification{
+			AccountId:       aws.String(tfMap["account_id"].(string)),
+			DisplayName:     aws.String(tfMap["display_name"].(string)),
+			MemberAbilities: flex.ExpandStringSet(tfMap["member_abilities"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDataEncryptionMetadata(apiObject *cleanrooms.DataEncryptionMetadata) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_clear_text": aws.BoolValue(apiObject.AllowCleartext),
+		"allow_duplicates": aws.BoolValue(apiObject.AllowDuplicates),
+		"allow_joins_on_columns_with_different_names": aws.BoolValue(apiObject.AllowJoinsOnColumnsWithDifferentNames),
+		"preserve_nulls": aws.BoolValue(apiObject.PreserveNulls),
+	}
+
+	return []interface{}{tfMap}
+}
+
+// flattenMemberSummaries returns members in the order they appear in the configuration,
+// followed by any members not present in the configuration.
+func flattenMemberSummaries(apiObjects []*cleanrooms.MemberSummary, configured []interface{}) []interface{} {
+	byAccountID := make(map[string]*cleanrooms.MemberSummary, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		byAccountID[aws.StringValue(apiObject.AccountId)] = apiObject
+	}
+
+	var tfList []interface{}
+
+	flatten := func(apiObject *cleanrooms.MemberSummary) {
+		tfList = append(tfList, map[string]interface{}{
+			"account_id":       aws.StringValue(apiObject.AccountId),
+			"display_name":     aws.StringValue(apiObject.DisplayName),
+			"member_abilities": aws.StringValueSlice(apiObject.Abilities),
+			"status":           aws.StringValue(apiObject.Status),
+		})
+	}
+
+	for _, tfMapRaw := range configured {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		accountID := tfMap["account_id"].(string)
+
+		if apiObject, ok := byAccountID[accountID]; ok {
+			flatten(apiObject)
+			delete(byAccountID, accountID)
+		}
+	}
+
+	for _, apiObject := range apiObjects {
+		if _, ok := byAccountID[aws.StringValue(apiObject.AccountId)]; ok {
+			flatten(apiObject)
+		}
+	}
+
+	return tfList
+}
@@ -0,0 +1,319 @@
+package cleanrooms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsCollaboration_basic(t *testing.T) {
+	var v cleanrooms.Collaboration
+	resourceName := "aws_cleanrooms_collaboration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cleanrooms.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollaborationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollaborationConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms", regexp.MustCompile(`collaboration/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "creator_display_name", "creator"),
+					resource.TestCheckResourceAttr(resourceName, "creator_member_abilities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data_encryption_metadata.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_encryption_metadata.0.allow_clear_text", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data_encryption_metadata.0.allow_duplicates", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data_encryption_metadata.0.allow_joins_on_columns_with_different_names", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data_encryption_metadata.0.preserve_nulls", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", cleanrooms.CollaborationQueryLogStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCollaborationConfig(rName+"-updated", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsCollaboration_disappears(t *testing.T) {
+	var v cleanrooms.Collaboration
+	resourceName := "aws_cleanrooms_collaboration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cleanrooms.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollaborationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollaborationConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceCollaboration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsCollaboration_tags(t *testing.T) {
+	var v cleanrooms.Collaboration
+	resourceName := "aws_cleanrooms_collaboration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cleanrooms.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollaborationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollaborationTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCollaborationTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCollaborationTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsCollaboration_member(t *testing.T) {
+	var v1, v2 cleanrooms.Collaboration
+	var providers []*schema.Provider
+	resourceName := "aws_cleanrooms_collaboration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cleanrooms.EndpointsID, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckCollaborationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollaborationMemberConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "member.0.account_id", "data.aws_caller_identity.member", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "member.0.display_name", "member"),
+					resource.TestCheckResourceAttr(resourceName, "member.0.member_abilities.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "member.0.status", cleanrooms.MemberStatusInvited),
+				),
+			},
+			{
+				// Removing a member updates the collaboration in place.
+				Config: testAccCollaborationMemberRemovedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(resourceName, &v2),
+					testAccCheckCollaborationNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCollaborationExists(n string, v *cleanrooms.Collaboration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Collaboration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindCollaborationByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCollaborationNotRecreated(before, after *cleanrooms.Collaboration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := *before.Id, *after.Id; before != after {
+			return fmt.Errorf("Clean Rooms Collaboration (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCollaborationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_collaboration" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindCollaborationByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Collaboration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCollaborationConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  description              = %[2]q
+  creator_display_name     = "creator"
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  query_log_status         = "DISABLED"
+
+  data_encryption_metadata {
+    allow_clear_text                            = true
+    allow_duplicates                            = true
+    allow_joins_on_columns_with_different_names = true
+    preserve_nulls                              = false
+  }
+}
+`, rName, description)
+}
+
+func testAccCollaborationTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  description              = "test"
+  creator_display_name     = "creator"
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  query_log_status         = "DISABLED"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCollaborationTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  description              = "test"
+  creator_display_name     = "creator"
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  query_log_status         = "DISABLED"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccCollaborationMemberConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  description              = "test"
+  creator_display_name     = "creator"
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  query_log_status         = "DISABLED"
+
+  member {
+    account_id       = data.aws_caller_identity.member.account_id
+    display_name     = "member"
+    member_abilities = []
+  }
+}
+`, rName))
+}
+
+func testAccCollaborationMemberRemovedConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  description              = "test"
+  creator_display_name     = "creator"
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  query_log_status         = "DISABLED"
+}
+`, rName))
+}
@@ -0,0 +1,93 @@
+package cleanrooms
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCollaborationByID(conn *cleanrooms.CleanRooms, id string) (*cleanrooms.Collaboration, error) {
+	input := &cleanrooms.GetCollaborationInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCollaboration(input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Collaboration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Collaboration, nil
+}
+
+func FindMembersByCollaborationID(conn *cleanrooms.CleanRooms, id string) ([]*cleanrooms.MemberSummary, error) {
+	input := &cleanrooms.ListMembersInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+	var output []*cleanrooms.MemberSummary
+
+	err := conn.ListMembersPages(input, func(page *cleanrooms.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MemberSummaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindMembershipByID(conn *cleanrooms.CleanRooms, id string) (*cleanrooms.Membership, error) {
+	input := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetMembership(input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Membership, nil
+}
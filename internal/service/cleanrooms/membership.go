@@ -0,0 +1,365 @@
+package cleanrooms
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMembershipCreate,
+		Read:   resourceMembershipRead,
+		Update: resourceMembershipUpdate,
+		Delete: resourceMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"collaboration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_result_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"output_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"key_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"result_format": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(cleanrooms.ResultFormat_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"member_abilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_log_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.MembershipQueryLogStatus_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	collaborationID := d.Get("collaboration_id").(string)
+	input := &cleanrooms.CreateMembershipInput{
+		CollaborationIdentifier: aws.String(collaborationID),
+		QueryLogStatus:          aws.String(d.Get("query_log_status").(string)),
+	}
+
+	if v, ok := d.GetOk("default_result_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DefaultResultConfiguration = expandMembershipProtectedQueryResultConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Membership: %s", input)
+	output, err := conn.CreateMembership(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Clean Rooms Membership (collaboration %s): %w", collaborationID, err)
+	}
+
+	d.SetId(aws.StringValue(output.Membership.Id))
+
+	return resourceMembershipRead(d, meta)
+}
+
+func resourceMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	membership, err := FindMembershipByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Clean Rooms Membership (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(membership.Status); !d.IsNewResource() && (status == cleanrooms.MembershipStatusRemoved || status == cleanrooms.MembershipStatusCollaborationDeleted) {
+		log.Printf("[WARN] Clean Rooms Membership (%s) %s, removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(membership.Arn)
+	d.Set("arn", arn)
+	d.Set("collaboration_arn", membership.CollaborationArn)
+	d.Set("collaboration_creator_account_id", membership.CollaborationCreatorAccountId)
+	d.Set("collaboration_creator_display_name", membership.CollaborationCreatorDisplayName)
+	d.Set("collaboration_id", membership.CollaborationId)
+	d.Set("collaboration_name", membership.CollaborationName)
+	d.Set("create_time", aws.TimeValue(membership.CreateTime).Format(time.RFC3339))
+	if err := d.Set("default_result_configuration", flattenMembershipProtectedQueryResultConfiguration(membership.DefaultResultConfiguration)); err != nil {
+		return fmt.Errorf("error setting default_result_configuration: %w", err)
+	}
+	d.Set("member_abilities", aws.StringValueSlice(membership.MemberAbilities))
+	d.Set("query_log_status", membership.QueryLogStatus)
+	d.Set("status", membership.Status)
+	d.Set("update_time", aws.TimeValue(membership.UpdateTime).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Clean Rooms Membership (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &cleanrooms.UpdateMembershipInput{
+			MembershipIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("default_result_configuration") {
+			if v, ok := d.GetOk("default_result_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DefaultResultConfiguration = expandMembershipProtectedQueryResultConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("query_log_status") {
+			input.QueryLogStatus = aws.String(d.Get("query_log_status").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Membership: %s", input)
+		_, err := conn.UpdateMembership(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Clean Rooms Membership (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Clean Rooms Membership (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceMembershipRead(d, meta)
+}
+
+func resourceMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Membership: %s", d.Id())
+	_, err := conn.DeleteMembership(&cleanrooms.DeleteMembershipInput{
+		MembershipIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Clean Rooms Membership (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMembershipProtectedQueryResultConfiguration(tfMap map[string]interface{}) *cleanrooms.MembershipProtectedQueryResultConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.MembershipProtectedQueryResultConfiguration{}
+
+	if v, ok := tfMap["output_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OutputConfiguration = expandMembershipProtectedQueryOutputConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMembershipProtectedQueryOutputConfiguration(tfMap map[string]interface{}) *cleanrooms.MembershipProtectedQueryOutputConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.MembershipProtectedQueryOutputConfiguration{}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3 = expandProtectedQueryS3OutputConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandProtectedQueryS3OutputConfiguration(tfMap map[string]interface{}) *cleanrooms.ProtectedQueryS3OutputConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.ProtectedQueryS3OutputConfiguration{
+		Bucket:       aws.String(tfMap["bucket"].(string)),
+		ResultFormat: aws.String(tfMap["result_format"].(string)),
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMembershipProtectedQueryResultConfiguration(apiObject *cleanrooms.MembershipProtectedQueryResultConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"output_configuration": flattenMembershipProtectedQueryOutputConfiguration(apiObject.OutputConfiguration),
+		"role_arn":             aws.StringValue(apiObject.RoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMembershipProtectedQueryOutputConfiguration(apiObject *cleanrooms.MembershipProtectedQueryOutputConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"s3": flattenProtectedQueryS3OutputConfiguration(apiObject.S3),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenProtectedQueryS3OutputConfiguration(apiObject *cleanrooms.ProtectedQueryS3OutputConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":        aws.StringValue(apiObject.Bucket),
+		"key_prefix":    aws.StringValue(apiObject.KeyPrefix),
+		"result_format": aws.StringValue(apiObject.ResultFormat),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,296 @@
+package cleanrooms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsMembership_basic(t *testing.T) {
+	var v cleanrooms.Membership
+	resourceName := "aws_cleanrooms_membership.test"
+	collaborationResourceName := "aws_cleanrooms_collaboration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cleanrooms.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms", regexp.MustCompile(`membership/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_arn", collaborationResourceName, "arn"),
+					acctest.CheckResourceAttrAccountID(resourceName, "collaboration_creator_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_creator_display_name", "creator"),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", collaborationResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "member_abilities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", cleanrooms.MembershipQueryLogStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "status", cleanrooms.MembershipStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMembershipConfig(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", cleanrooms.MembershipQueryLogStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_disappears(t *testing.T) {
+	var v cleanrooms.Membership
+	resourceName := "aws_cleanrooms_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cleanrooms.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_defaultResultConfiguration(t *testing.T) {
+	var v cleanrooms.Membership
+	resourceName := "aws_cleanrooms_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cleanrooms.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipDefaultResultConfigurationConfig(rName, "CSV"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.key_prefix", "results/"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "CSV"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMembershipDefaultResultConfigurationConfig(rName, "PARQUET"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "PARQUET"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_tags(t *testing.T) {
+	var v cleanrooms.Membership
+	resourceName := "aws_cleanrooms_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cleanrooms.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMembershipTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMembershipTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMembershipExists(n string, v *cleanrooms.Membership) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Membership ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindMembershipByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMembershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_membership" {
+			continue
+		}
+
+		output, err := tfcleanrooms.FindMembershipByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Status); status == cleanrooms.MembershipStatusRemoved || status == cleanrooms.MembershipStatusCollaborationDeleted {
+			continue
+		}
+
+		return fmt.Errorf("Clean Rooms Membership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMembershipBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  description              = "test"
+  creator_display_name     = "creator"
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  query_log_status         = "ENABLED"
+}
+`, rName)
+}
+
+func testAccMembershipConfig(rName, queryLogStatus string) string {
+	return acctest.ConfigCompose(testAccMembershipBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = %[1]q
+}
+`, queryLogStatus))
+}
+
+func testAccMembershipDefaultResultConfigurationConfig(rName, resultFormat string) string {
+	return acctest.ConfigCompose(testAccMembershipBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = "DISABLED"
+
+  default_result_configuration {
+    output_configuration {
+      s3 {
+        bucket        = aws_s3_bucket.test.bucket
+        key_prefix    = "results/"
+        result_format = %[2]q
+      }
+    }
+  }
+}
+`, rName, resultFormat))
+}
+
+func testAccMembershipTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMembershipBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = "DISABLED"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccMembershipTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMembershipBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = "DISABLED"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
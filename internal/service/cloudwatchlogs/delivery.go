@@ -0,0 +1,112 @@
+package cloudwatchlogs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDelivery() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDeliveryCreate,
+		Read:   resourceDeliveryRead,
+		Delete: resourceDeliveryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_destination_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"delivery_destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_source_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+		},
+	}
+}
+
+func resourceDeliveryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	input := &cloudwatchlogs.CreateDeliveryInput{
+		DeliveryDestinationArn: aws.String(d.Get("delivery_destination_arn").(string)),
+		DeliverySourceName:     aws.String(d.Get("delivery_source_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Logs Delivery: %s", input)
+	output, err := conn.CreateDelivery(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Logs Delivery: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Delivery.Id))
+
+	return resourceDeliveryRead(d, meta)
+}
+
+func resourceDeliveryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	delivery, err := FindDeliveryByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Logs Delivery (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", delivery.Arn)
+	d.Set("delivery_destination_arn", delivery.DeliveryDestinationArn)
+	d.Set("delivery_destination_type", delivery.DeliveryDestinationType)
+	d.Set("delivery_source_name", delivery.DeliverySourceName)
+
+	return nil
+}
+
+func resourceDeliveryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Delivery: %s", d.Id())
+	_, err := conn.DeleteDelivery(&cloudwatchlogs.DeleteDeliveryInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Logs Delivery (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
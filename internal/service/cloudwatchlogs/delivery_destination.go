@@ -0,0 +1,173 @@
+package cloudwatchlogs
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDeliveryDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDeliveryDestinationPut,
+		Read:   resourceDeliveryDestinationRead,
+		Update: resourceDeliveryDestinationPut,
+		Delete: resourceDeliveryDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_destination_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_resource_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"delivery_destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validation.StringMatch(regexp.MustCompile(`^[\w-]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"output_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchlogs.OutputFormat_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDeliveryDestinationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	name := d.Get("name").(string)
+	input := &cloudwatchlogs.PutDeliveryDestinationInput{
+		DeliveryDestinationConfiguration: expandDeliveryDestinationConfiguration(d.Get("delivery_destination_configuration").([]interface{})[0].(map[string]interface{})),
+		Name:                             aws.String(name),
+	}
+
+	if v, ok := d.GetOk("output_format"); ok {
+		input.OutputFormat = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting CloudWatch Logs Delivery Destination: %s", input)
+	_, err := conn.PutDeliveryDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting CloudWatch Logs Delivery Destination (%s): %w", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return resourceDeliveryDestinationRead(d, meta)
+}
+
+func resourceDeliveryDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	destination, err := FindDeliveryDestinationByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Logs Delivery Destination (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", destination.Arn)
+	if destination.DeliveryDestinationConfiguration != nil {
+		if err := d.Set("delivery_destination_configuration", []interface{}{flattenDeliveryDestinationConfiguration(destination.DeliveryDestinationConfiguration)}); err != nil {
+			return fmt.Errorf("error setting delivery_destination_configuration: %w", err)
+		}
+	} else {
+		d.Set("delivery_destination_configuration", nil)
+	}
+	d.Set("delivery_destination_type", destination.DeliveryDestinationType)
+	d.Set("name", destination.Name)
+	d.Set("output_format", destination.OutputFormat)
+
+	return nil
+}
+
+func resourceDeliveryDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Delivery Destination: %s", d.Id())
+	_, err := conn.DeleteDeliveryDestination(&cloudwatchlogs.DeleteDeliveryDestinationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Logs Delivery Destination (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDeliveryDestinationConfiguration(tfMap map[string]interface{}) *cloudwatchlogs.DeliveryDestinationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatchlogs.DeliveryDestinationConfiguration{}
+
+	if v, ok := tfMap["destination_resource_arn"].(string); ok && v != "" {
+		apiObject.DestinationResourceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeliveryDestinationConfiguration(apiObject *cloudwatchlogs.DeliveryDestinationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DestinationResourceArn; v != nil {
+		tfMap["destination_resource_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
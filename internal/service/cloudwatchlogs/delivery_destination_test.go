@@ -0,0 +1,149 @@
+package cloudwatchlogs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatchlogs "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudWatchLogsDeliveryDestination_basic(t *testing.T) {
+	var destination cloudwatchlogs.DeliveryDestination
+	resourceName := "aws_cloudwatch_log_delivery_destination.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeliveryDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationConfig(rName, "json"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryDestinationExists(resourceName, &destination),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "logs", regexp.MustCompile(`delivery-destination:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "delivery_destination_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "delivery_destination_configuration.0.destination_resource_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_destination_type", cloudwatchlogs.DeliveryDestinationTypeS3),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "json"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeliveryDestinationConfig(rName, "plain"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryDestinationExists(resourceName, &destination),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "plain"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchLogsDeliveryDestination_disappears(t *testing.T) {
+	var destination cloudwatchlogs.DeliveryDestination
+	resourceName := "aws_cloudwatch_log_delivery_destination.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeliveryDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationConfig(rName, "json"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryDestinationExists(resourceName, &destination),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudwatchlogs.ResourceDeliveryDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeliveryDestinationExists(n string, v *cloudwatchlogs.DeliveryDestination) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Logs Delivery Destination ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+
+		output, err := tfcloudwatchlogs.FindDeliveryDestinationByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeliveryDestinationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_log_delivery_destination" {
+			continue
+		}
+
+		_, err := tfcloudwatchlogs.FindDeliveryDestinationByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Logs Delivery Destination %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDeliveryDestinationBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDeliveryDestinationConfig(rName, outputFormat string) string {
+	return acctest.ConfigCompose(testAccDeliveryDestinationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_delivery_destination" "test" {
+  name          = %[1]q
+  output_format = %[2]q
+
+  delivery_destination_configuration {
+    destination_resource_arn = aws_s3_bucket.test.arn
+  }
+}
+`, rName, outputFormat))
+}
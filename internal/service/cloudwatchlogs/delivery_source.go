@@ -0,0 +1,131 @@
+package cloudwatchlogs
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDeliverySource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDeliverySourcePut,
+		Read:   resourceDeliverySourceRead,
+		Update: resourceDeliverySourcePut,
+		Delete: resourceDeliverySourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validation.StringMatch(regexp.MustCompile(`^[\w-]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeliverySourcePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	name := d.Get("name").(string)
+	input := &cloudwatchlogs.PutDeliverySourceInput{
+		LogType:     aws.String(d.Get("log_type").(string)),
+		Name:        aws.String(name),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting CloudWatch Logs Delivery Source: %s", input)
+	_, err := conn.PutDeliverySource(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting CloudWatch Logs Delivery Source (%s): %w", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return resourceDeliverySourceRead(d, meta)
+}
+
+func resourceDeliverySourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	source, err := FindDeliverySourceByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Logs Delivery Source (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", source.Arn)
+	d.Set("log_type", source.LogType)
+	d.Set("name", source.Name)
+	if len(source.ResourceArns) > 0 {
+		d.Set("resource_arn", source.ResourceArns[0])
+	} else {
+		d.Set("resource_arn", nil)
+	}
+	d.Set("service", source.Service)
+
+	return nil
+}
+
+func resourceDeliverySourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Delivery Source: %s", d.Id())
+	_, err := conn.DeleteDeliverySource(&cloudwatchlogs.DeleteDeliverySourceInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Logs Delivery Source (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
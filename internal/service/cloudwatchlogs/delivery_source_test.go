@@ -0,0 +1,122 @@
+package cloudwatchlogs_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatchlogs "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// testAccPreCheckDeliverySource skips the test unless a resource that can send logs
+// through CloudWatch Logs deliveries is available, e.g. a CodeWhisperer customization.
+func testAccPreCheckDeliverySource(t *testing.T) (string, string) {
+	resourceARN := os.Getenv("CLOUDWATCH_LOG_DELIVERY_SOURCE_RESOURCE_ARN")
+	logType := os.Getenv("CLOUDWATCH_LOG_DELIVERY_SOURCE_LOG_TYPE")
+
+	if resourceARN == "" || logType == "" {
+		t.Skip("Environment variables CLOUDWATCH_LOG_DELIVERY_SOURCE_RESOURCE_ARN and CLOUDWATCH_LOG_DELIVERY_SOURCE_LOG_TYPE are not set")
+	}
+
+	return resourceARN, logType
+}
+
+func TestAccCloudWatchLogsDeliverySource_basic(t *testing.T) {
+	resourceARN, logType := testAccPreCheckDeliverySource(t)
+
+	var source cloudwatchlogs.DeliverySource
+	resourceName := "aws_cloudwatch_log_delivery_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeliverySourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliverySourceConfig(rName, resourceARN, logType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliverySourceExists(resourceName, &source),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "logs", regexp.MustCompile(`delivery-source:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "log_type", logType),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arn", resourceARN),
+					resource.TestCheckResourceAttrSet(resourceName, "service"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeliverySourceExists(n string, v *cloudwatchlogs.DeliverySource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Logs Delivery Source ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+
+		output, err := tfcloudwatchlogs.FindDeliverySourceByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeliverySourceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_log_delivery_source" {
+			continue
+		}
+
+		_, err := tfcloudwatchlogs.FindDeliverySourceByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Logs Delivery Source %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDeliverySourceConfig(rName, resourceARN, logType string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_delivery_source" "test" {
+  name         = %[1]q
+  log_type     = %[3]q
+  resource_arn = %[2]q
+}
+`, rName, resourceARN, logType)
+}
@@ -0,0 +1,109 @@
+package cloudwatchlogs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatchlogs "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudWatchLogsDelivery_basic(t *testing.T) {
+	resourceARN, logType := testAccPreCheckDeliverySource(t)
+
+	var delivery cloudwatchlogs.Delivery
+	resourceName := "aws_cloudwatch_log_delivery.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeliveryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryConfig(rName, resourceARN, logType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryExists(resourceName, &delivery),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "logs", regexp.MustCompile(`delivery:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "delivery_destination_arn", "aws_cloudwatch_log_delivery_destination.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_destination_type", cloudwatchlogs.DeliveryDestinationTypeS3),
+					resource.TestCheckResourceAttrPair(resourceName, "delivery_source_name", "aws_cloudwatch_log_delivery_source.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeliveryExists(n string, v *cloudwatchlogs.Delivery) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Logs Delivery ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+
+		output, err := tfcloudwatchlogs.FindDeliveryByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeliveryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_log_delivery" {
+			continue
+		}
+
+		_, err := tfcloudwatchlogs.FindDeliveryByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Logs Delivery %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDeliveryConfig(rName, resourceARN, logType string) string {
+	return acctest.ConfigCompose(
+		testAccDeliveryDestinationConfig(rName, "json"),
+		testAccDeliverySourceConfig(rName, resourceARN, logType),
+		`
+resource "aws_cloudwatch_log_delivery" "test" {
+  delivery_source_name     = aws_cloudwatch_log_delivery_source.test.name
+  delivery_destination_arn = aws_cloudwatch_log_delivery_destination.test.arn
+}
+`)
+}
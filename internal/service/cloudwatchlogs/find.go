@@ -5,6 +5,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindQueryDefinition(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, name, queryDefinitionID string) (*cloudwatchlogs.QueryDefinition, error) {
@@ -31,3 +34,78 @@ func FindQueryDefinition(ctx context.Context, conn *cloudwatchlogs.CloudWatchLog
 
 	return result, err
 }
+
+func FindDeliveryByID(conn *cloudwatchlogs.CloudWatchLogs, id string) (*cloudwatchlogs.Delivery, error) {
+	input := &cloudwatchlogs.GetDeliveryInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetDelivery(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Delivery == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Delivery, nil
+}
+
+func FindDeliveryDestinationByName(conn *cloudwatchlogs.CloudWatchLogs, name string) (*cloudwatchlogs.DeliveryDestination, error) {
+	input := &cloudwatchlogs.GetDeliveryDestinationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDeliveryDestination(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeliveryDestination == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeliveryDestination, nil
+}
+
+func FindDeliverySourceByName(conn *cloudwatchlogs.CloudWatchLogs, name string) (*cloudwatchlogs.DeliverySource, error) {
+	input := &cloudwatchlogs.GetDeliverySourceInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDeliverySource(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeliverySource == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeliverySource, nil
+}
@@ -1,6 +1,7 @@
 package codepipeline
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -57,6 +59,12 @@ func ResourceCodePipeline() *schema.Resource {
 				),
 			},
 
+			"pipeline_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(codepipeline.PipelineType_Values(), false),
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -204,12 +212,129 @@ func ResourceCodePipeline() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"git_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"push": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tags": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"excludes": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 8,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: validation.StringLenBetween(1, 255),
+																},
+															},
+															"includes": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 8,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: validation.StringLenBetween(1, 255),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"source_action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 100),
+											validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
+										),
+									},
+								},
+							},
+						},
+						"provider_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(codepipeline.PipelineTriggerProviderType_Values(), false),
+						},
+					},
+				},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9@\-_]+$`), "must contain only alphanumeric, at sign, hyphen and underscore characters"),
+							),
+						},
+					},
+				},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceCodePipelineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// resourceCodePipelineCustomizeDiff ensures that triggers and variables, which are
+// only supported by V2 pipelines, are not configured for a V1 pipeline.
+func resourceCodePipelineCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if pipelineType := diff.Get("pipeline_type").(string); pipelineType == codepipeline.PipelineTypeV2 {
+		return nil
+	}
+
+	// trigger is Computed, so only the configuration is checked.
+	if v := diff.GetRawConfig().GetAttr("trigger"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		return fmt.Errorf("trigger can only be set when pipeline_type is %q", codepipeline.PipelineTypeV2)
+	}
+
+	if v, ok := diff.GetOk("variable"); ok && len(v.([]interface{})) > 0 {
+		return fmt.Errorf("variable can only be set when pipeline_type is %q", codepipeline.PipelineTypeV2)
+	}
+
+	return nil
+}
+
 func resourceCodePipelineCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CodePipelineConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -262,6 +387,18 @@ func expand(d *schema.ResourceData) (*codepipeline.PipelineDeclaration, error) {
 		Stages:  expandStages(d),
 	}
 
+	if v, ok := d.GetOk("pipeline_type"); ok {
+		pipeline.PipelineType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+		pipeline.Triggers = expandPipelineTriggerDeclarations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("variable"); ok && len(v.([]interface{})) > 0 {
+		pipeline.Variables = expandPipelineVariableDeclarations(v.([]interface{}))
+	}
+
 	pipelineArtifactStores, err := ExpandArtifactStores(d.Get("artifact_store").(*schema.Set).List())
 	if err != nil {
 		return nil, err
@@ -529,6 +666,163 @@ func flattenActionsInputArtifacts(artifacts []*codepipeline.InputArtifact) []str
 	return values
 }
 
+func expandPipelineTriggerDeclarations(tfList []interface{}) []*codepipeline.PipelineTriggerDeclaration {
+	var apiObjects []*codepipeline.PipelineTriggerDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.PipelineTriggerDeclaration{
+			ProviderType: aws.String(tfMap["provider_type"].(string)),
+		}
+
+		if v, ok := tfMap["git_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.GitConfiguration = expandGitConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitConfiguration(tfMap map[string]interface{}) *codepipeline.GitConfiguration {
+	apiObject := &codepipeline.GitConfiguration{
+		SourceActionName: aws.String(tfMap["source_action_name"].(string)),
+	}
+
+	if v, ok := tfMap["push"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			push := &codepipeline.GitPushFilter{}
+
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					push.Tags = expandGitTagFilterCriteria(v[0].(map[string]interface{}))
+				}
+			}
+
+			apiObject.Push = append(apiObject.Push, push)
+		}
+	}
+
+	return apiObject
+}
+
+func expandGitTagFilterCriteria(tfMap map[string]interface{}) *codepipeline.GitTagFilterCriteria {
+	apiObject := &codepipeline.GitTagFilterCriteria{}
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Excludes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Includes = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandPipelineVariableDeclarations(tfList []interface{}) []*codepipeline.PipelineVariableDeclaration {
+	var apiObjects []*codepipeline.PipelineVariableDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.PipelineVariableDeclaration{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["default_value"].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPipelineTriggerDeclarations(apiObjects []*codepipeline.PipelineTriggerDeclaration) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"provider_type": aws.StringValue(apiObject.ProviderType),
+		}
+
+		if v := apiObject.GitConfiguration; v != nil {
+			tfMap["git_configuration"] = []interface{}{flattenGitConfiguration(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitConfiguration(apiObject *codepipeline.GitConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"source_action_name": aws.StringValue(apiObject.SourceActionName),
+	}
+
+	var push []interface{}
+
+	for _, v := range apiObject.Push {
+		if v == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := v.Tags; v != nil {
+			tfMap["tags"] = []interface{}{map[string]interface{}{
+				"excludes": aws.StringValueSlice(v.Excludes),
+				"includes": aws.StringValueSlice(v.Includes),
+			}}
+		}
+
+		push = append(push, tfMap)
+	}
+
+	tfMap["push"] = push
+
+	return tfMap
+}
+
+func flattenPipelineVariableDeclarations(apiObjects []*codepipeline.PipelineVariableDeclaration) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"default_value": aws.StringValue(apiObject.DefaultValue),
+			"description":   aws.StringValue(apiObject.Description),
+			"name":          aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
 func resourceCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CodePipelineConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -568,8 +862,17 @@ func resourceCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
 	arn := aws.StringValue(metadata.PipelineArn)
 	d.Set("arn", arn)
 	d.Set("name", pipeline.Name)
+	d.Set("pipeline_type", pipeline.PipelineType)
 	d.Set("role_arn", pipeline.RoleArn)
 
+	if err := d.Set("trigger", flattenPipelineTriggerDeclarations(pipeline.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %w", err)
+	}
+
+	if err := d.Set("variable", flattenPipelineVariableDeclarations(pipeline.Variables)); err != nil {
+		return fmt.Errorf("error setting variable: %w", err)
+	}
+
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
@@ -554,6 +554,79 @@ func TestAccCodePipeline_withGitHubV1SourceAction(t *testing.T) {
 	})
 }
 
+func TestAccCodePipeline_pipelineTypeV2(t *testing.T) {
+	var p1, p2 codepipeline.PipelineDeclaration
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+			acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig_pipelineTypeV2(name, "v1.*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &p1),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", "V2"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.provider_type", "CodeStarSourceConnection"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.source_action_name", "Source"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.tags.0.includes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.tags.0.includes.0", "v1.*"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.tags.0.excludes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.tags.0.excludes.0", "v1.0.*"),
+					resource.TestCheckResourceAttr(resourceName, "variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.name", "test_var1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.default_value", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.description", "Test variable"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig_pipelineTypeV2(name, "v2.*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &p2),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", "V2"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.tags.0.includes.0", "v2.*"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodePipeline_pipelineTypeV1WithTrigger(t *testing.T) {
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+			acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfig_pipelineTypeV1WithTrigger(name),
+				ExpectError: regexp.MustCompile(`trigger can only be set when pipeline_type is "V2"`),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(n string, pipeline *codepipeline.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1427,6 +1500,153 @@ resource "aws_s3_bucket" "foo" {
 `, rName))
 }
 
+func testAccPipelineTypeBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName))
+}
+
+func testAccConfig_pipelineTypeV2(rName, tagInclude string) string {
+	return acctest.ConfigCompose(
+		testAccPipelineTypeBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  pipeline_type = "V2"
+  role_arn      = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  trigger {
+    provider_type = "CodeStarSourceConnection"
+
+    git_configuration {
+      source_action_name = "Source"
+
+      push {
+        tags {
+          includes = [%[2]q]
+          excludes = ["v1.0.*"]
+        }
+      }
+    }
+  }
+
+  variable {
+    name          = "test_var1"
+    default_value = "value1"
+    description   = "Test variable"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName, tagInclude))
+}
+
+func testAccConfig_pipelineTypeV1WithTrigger(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipelineTypeBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  pipeline_type = "V1"
+  role_arn      = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  trigger {
+    provider_type = "CodeStarSourceConnection"
+
+    git_configuration {
+      source_action_name = "Source"
+    }
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccConfig_WithGitHubv1SourceAction(rName, githubToken string) string {
 	return acctest.ConfigCompose(
 		testAccS3DefaultBucket(rName),
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,6 +23,16 @@ func ResourceTableItem() *schema.Resource {
 		Delete: resourceTableItemDelete,
 
 		Schema: map[string]*schema.Schema{
+			"condition_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"expression_attribute_values": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"condition_expression"},
+				ValidateFunc: validateDynamoDbTableItem,
+			},
 			"table_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -65,18 +76,31 @@ func resourceTableItemCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	log.Printf("[DEBUG] DynamoDB item create: %s", tableName)
+	input := &dynamodb.PutItemInput{
+		Item:      attributes,
+		TableName: aws.String(tableName),
+	}
 
-	_, err = conn.PutItem(&dynamodb.PutItemInput{
-		Item: attributes,
+	if v, ok := d.GetOk("condition_expression"); ok {
+		// The condition decides whether an existing item may be overwritten.
+		input.ConditionExpression = aws.String(v.(string))
+
+		input.ExpressionAttributeValues, err = expandTableItemExpressionAttributeValues(d.Get("expression_attribute_values").(string))
+		if err != nil {
+			return err
+		}
+	} else {
 		// Explode if item exists. We didn't create it.
-		Expected: map[string]*dynamodb.ExpectedAttributeValue{
+		input.Expected = map[string]*dynamodb.ExpectedAttributeValue{
 			hashKey: {
 				Exists: aws.Bool(false),
 			},
-		},
-		TableName: aws.String(tableName),
-	})
+		}
+	}
+
+	log.Printf("[DEBUG] DynamoDB item create: %s", tableName)
+
+	_, err = conn.PutItem(input)
 	if err != nil {
 		return err
 	}
@@ -119,12 +143,38 @@ func resourceTableItemUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		_, err = conn.UpdateItem(&dynamodb.UpdateItemInput{
-			AttributeUpdates: updates,
-			TableName:        aws.String(tableName),
-			Key:              newQueryKey,
-		})
+		input := &dynamodb.UpdateItemInput{
+			TableName: aws.String(tableName),
+			Key:       newQueryKey,
+		}
+
+		var conditionExpression *string
+		var conditionValues map[string]*dynamodb.AttributeValue
+		if v, ok := d.GetOk("condition_expression"); ok {
+			conditionExpression = aws.String(v.(string))
+
+			conditionValues, err = expandTableItemExpressionAttributeValues(d.Get("expression_attribute_values").(string))
+			if err != nil {
+				return err
+			}
+		}
+
+		if conditionExpression != nil {
+			// AttributeUpdates is a legacy parameter that cannot be combined with
+			// ConditionExpression, so the updates are sent as an update expression.
+			input.ConditionExpression = conditionExpression
+
+			if err := buildTableItemUpdateExpression(input, updates, conditionValues); err != nil {
+				return err
+			}
+		} else {
+			input.AttributeUpdates = updates
+		}
+
+		_, err = conn.UpdateItem(input)
 		if err != nil {
+			// Keep the previous item and condition in state, e.g. when the condition check failed.
+			d.Partial(true)
 			return err
 		}
 
@@ -140,8 +190,10 @@ func resourceTableItemUpdate(d *schema.ResourceData, meta interface{}) error {
 		if !reflect.DeepEqual(oldQueryKey, newQueryKey) {
 			log.Printf("[DEBUG] Deleting old record: %#v", oldQueryKey)
 			_, err := conn.DeleteItem(&dynamodb.DeleteItemInput{
-				Key:       oldQueryKey,
-				TableName: aws.String(tableName),
+				Key:                       oldQueryKey,
+				TableName:                 aws.String(tableName),
+				ConditionExpression:       conditionExpression,
+				ExpressionAttributeValues: conditionValues,
 			})
 			if err != nil {
 				return err
@@ -216,10 +268,21 @@ func resourceTableItemDelete(d *schema.ResourceData, meta interface{}) error {
 	rangeKey := d.Get("range_key").(string)
 	queryKey := BuildTableItemqueryKey(attributes, hashKey, rangeKey)
 
-	_, err = conn.DeleteItem(&dynamodb.DeleteItemInput{
+	input := &dynamodb.DeleteItemInput{
 		Key:       queryKey,
 		TableName: aws.String(d.Get("table_name").(string)),
-	})
+	}
+
+	if v, ok := d.GetOk("condition_expression"); ok {
+		input.ConditionExpression = aws.String(v.(string))
+
+		input.ExpressionAttributeValues, err = expandTableItemExpressionAttributeValues(d.Get("expression_attribute_values").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = conn.DeleteItem(input)
 	return err
 }
 
@@ -258,6 +321,66 @@ func buildDynamoDbTableItemId(tableName string, hashKey string, rangeKey string,
 	return strings.Join(id, "|")
 }
 
+func expandTableItemExpressionAttributeValues(input string) (map[string]*dynamodb.AttributeValue, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	values, err := ExpandTableItemAttributes(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// DynamoDB rejects an empty ExpressionAttributeValues map.
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	return values, nil
+}
+
+// buildTableItemUpdateExpression sets input's UpdateExpression to SET each of the updated attributes.
+// The condition expression's attribute values are sent alongside those of the update expression.
+func buildTableItemUpdateExpression(input *dynamodb.UpdateItemInput, updates map[string]*dynamodb.AttributeValueUpdate, conditionValues map[string]*dynamodb.AttributeValue) error {
+	input.ExpressionAttributeValues = conditionValues
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(updates))
+	for key := range updates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	names := make(map[string]*string, len(keys))
+	values := make(map[string]*dynamodb.AttributeValue, len(keys)+len(conditionValues))
+	for k, v := range conditionValues {
+		values[k] = v
+	}
+
+	actions := make([]string, len(keys))
+	for i, key := range keys {
+		name := fmt.Sprintf("#tf_update_%d", i)
+		value := fmt.Sprintf(":tf_update_%d", i)
+
+		if _, ok := conditionValues[value]; ok {
+			return fmt.Errorf("expression_attribute_values cannot contain %s, it is reserved for item updates", value)
+		}
+
+		names[name] = aws.String(key)
+		values[value] = updates[key].Value
+		actions[i] = fmt.Sprintf("%s = %s", name, value)
+	}
+
+	input.ExpressionAttributeNames = names
+	input.ExpressionAttributeValues = values
+	input.UpdateExpression = aws.String("SET " + strings.Join(actions, ", "))
+
+	return nil
+}
+
 func BuildTableItemqueryKey(attrs map[string]*dynamodb.AttributeValue, hashKey string, rangeKey string) map[string]*dynamodb.AttributeValue {
 	queryKey := map[string]*dynamodb.AttributeValue{
 		hashKey: attrs[hashKey],
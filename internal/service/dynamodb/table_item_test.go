@@ -2,6 +2,7 @@ package dynamodb_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -235,6 +236,61 @@ func TestAccDynamoDBTableItem_updateWithRangeKey(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTableItem_conditionExpression(t *testing.T) {
+	var conf dynamodb.GetItemOutput
+
+	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	hashKey := "hashKey"
+
+	itemBefore := `{
+	"hashKey": {"S": "something"},
+	"managed_by": {"S": "terraform"},
+	"one": {"N": "11111"}
+}`
+	itemAfter := `{
+	"hashKey": {"S": "something"},
+	"managed_by": {"S": "terraform"},
+	"one": {"N": "22222"}
+}`
+	itemRejected := `{
+	"hashKey": {"S": "something"},
+	"managed_by": {"S": "terraform"},
+	"one": {"N": "33333"}
+}`
+	conditionExpression := "managed_by = :managed_by"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccItemConditionExpressionConfig(tableName, hashKey, itemBefore, "attribute_not_exists(hashKey)", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemExists("aws_dynamodb_table_item.test", &conf),
+					testAccCheckTableItemCount(tableName, 1),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "condition_expression", "attribute_not_exists(hashKey)"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "item", itemBefore+"\n"),
+				),
+			},
+			{
+				Config: testAccItemConditionExpressionConfig(tableName, hashKey, itemAfter, conditionExpression, `{":managed_by": {"S": "terraform"}}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemExists("aws_dynamodb_table_item.test", &conf),
+					testAccCheckTableItemCount(tableName, 1),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "condition_expression", conditionExpression),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "item", itemAfter+"\n"),
+				),
+			},
+			{
+				Config:      testAccItemConditionExpressionConfig(tableName, hashKey, itemRejected, conditionExpression, `{":managed_by": {"S": "someone-else"}}`),
+				ExpectError: regexp.MustCompile(`ConditionalCheckFailedException`),
+			},
+		},
+	})
+}
+
 func testAccCheckItemDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
 
@@ -425,3 +481,36 @@ ITEM
 }
 `, tableName, hashKey, rangeKey, hashKey, rangeKey, firstItem, secondItem)
 }
+
+func testAccItemConditionExpressionConfig(tableName, hashKey, item, conditionExpression, expressionAttributeValues string) string {
+	values := "null"
+	if expressionAttributeValues != "" {
+		values = fmt.Sprintf("%q", expressionAttributeValues)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = %[2]q
+
+  attribute {
+    name = %[2]q
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_item" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  condition_expression        = %[4]q
+  expression_attribute_values = %[5]s
+
+  item = <<ITEM
+%[3]s
+ITEM
+}
+`, tableName, hashKey, item, conditionExpression, values)
+}
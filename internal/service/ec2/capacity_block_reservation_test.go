@@ -0,0 +1,90 @@
+package ec2_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+// Capacity Blocks are billed at purchase and cannot be cancelled, so this test only
+// runs when an offering ID is explicitly provided.
+func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
+	key := "EC2_CAPACITY_BLOCK_OFFERING_ID"
+	offeringID := os.Getenv(key)
+	if offeringID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v ec2.CapacityReservation
+	resourceName := "aws_ec2_capacity_block_reservation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		// Capacity Blocks cannot be cancelled, so there is nothing to check on destroy.
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockReservationConfig(offeringID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityBlockReservationExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`capacity-reservation/cr-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "capacity_block_offering_id", offeringID),
+					resource.TestCheckResourceAttrSet(resourceName, "end_date"),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", ec2.CapacityReservationInstancePlatformLinuxUnix),
+					resource.TestCheckResourceAttr(resourceName, "reservation_type", ec2.CapacityReservationTypeCapacityBlock),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "tf-acc-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityBlockReservationExists(n string, v *ec2.CapacityReservation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Capacity Block Reservation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindCapacityReservationByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCapacityBlockReservationConfig(offeringID string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_capacity_block_reservation" "test" {
+  capacity_block_offering_id = %[1]q
+  instance_platform          = "Linux/UNIX"
+
+  tags = {
+    Name = "tf-acc-test"
+  }
+}
+`, offeringID)
+}
@@ -20,6 +20,7 @@ const (
 	ErrCodeInvalidAllocationIDNotFound                  = "InvalidAllocationID.NotFound"
 	ErrCodeInvalidAssociationIDNotFound                 = "InvalidAssociationID.NotFound"
 	ErrCodeInvalidAttachmentIDNotFound                  = "InvalidAttachmentID.NotFound"
+	ErrCodeInvalidCapacityReservationIdNotFound         = "InvalidCapacityReservationId.NotFound"
 	ErrCodeInvalidCarrierGatewayIDNotFound              = "InvalidCarrierGatewayID.NotFound"
 	ErrCodeInvalidClientVpnAssociationIdNotFound        = "InvalidClientVpnAssociationId.NotFound"
 	ErrCodeInvalidClientVpnAuthorizationRuleNotFound    = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
@@ -49,6 +50,7 @@ const (
 	ErrCodeInvalidRouteTableIDNotFound                  = "InvalidRouteTableID.NotFound"
 	ErrCodeInvalidRouteTableIdNotFound                  = "InvalidRouteTableId.NotFound"
 	ErrCodeInvalidSecurityGroupIDNotFound               = "InvalidSecurityGroupID.NotFound"
+	ErrCodeInvalidSecurityGroupRuleIdNotFound           = "InvalidSecurityGroupRuleId.NotFound"
 	ErrCodeInvalidSnapshotInUse                         = "InvalidSnapshot.InUse"
 	ErrCodeInvalidSnapshotNotFound                      = "InvalidSnapshot.NotFound"
 	ErrCodeInvalidSpotInstanceRequestIDNotFound         = "InvalidSpotInstanceRequestID.NotFound"
@@ -68,6 +70,7 @@ const (
 	ErrCodeInvalidVpnGatewayAttachmentNotFound          = "InvalidVpnGatewayAttachment.NotFound"
 	ErrCodeInvalidVpnGatewayIDNotFound                  = "InvalidVpnGatewayID.NotFound"
 	ErrCodeNatGatewayNotFound                           = "NatGatewayNotFound"
+	ErrCodePrefixListVersionMismatch                    = "PrefixListVersionMismatch"
 	ErrCodeUnsupportedOperation                         = "UnsupportedOperation"
 )
 
@@ -11,6 +11,35 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+func FindCapacityReservationByID(conn *ec2.EC2, id string) (*ec2.CapacityReservation, error) {
+	input := &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeCapacityReservations(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidCapacityReservationIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CapacityReservations) == 0 || output.CapacityReservations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.CapacityReservations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.CapacityReservations[0], nil
+}
+
 // FindCarrierGatewayByID returns the carrier gateway corresponding to the specified identifier.
 // Returns nil and potentially an error if no carrier gateway is found.
 func FindCarrierGatewayByID(conn *ec2.EC2, id string) (*ec2.CarrierGateway, error) {
@@ -1148,6 +1177,80 @@ func FindSecurityGroups(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) (
 	return output, nil
 }
 
+// FindSecurityGroupRuleByID looks up a security group rule by ID. Returns a resource.NotFoundError if not found.
+func FindSecurityGroupRuleByID(conn *ec2.EC2, id string) (*ec2.SecurityGroupRule, error) {
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		SecurityGroupRuleIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindSecurityGroupRule(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.SecurityGroupRuleId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindSecurityGroupRule looks up a security group rule using an ec2.DescribeSecurityGroupRulesInput. Returns a resource.NotFoundError if not found.
+func FindSecurityGroupRule(conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) (*ec2.SecurityGroupRule, error) {
+	output, err := FindSecurityGroupRules(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindSecurityGroupRules(conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) ([]*ec2.SecurityGroupRule, error) {
+	var output []*ec2.SecurityGroupRule
+
+	err := conn.DescribeSecurityGroupRulesPages(input, func(page *ec2.DescribeSecurityGroupRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroupRules {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidGroupNotFound, ErrCodeInvalidSecurityGroupIDNotFound, ErrCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 // FindSpotInstanceRequestByID looks up a SpotInstanceRequest by ID. When not found, returns nil and potentially an API error.
 func FindSpotInstanceRequestByID(conn *ec2.EC2, id string) (*ec2.SpotInstanceRequest, error) {
 	input := &ec2.DescribeSpotInstanceRequestsInput{
@@ -2471,6 +2574,19 @@ func FindManagedPrefixListEntriesByID(conn *ec2.EC2, id string) ([]*ec2.PrefixLi
 		PrefixListId: aws.String(id),
 	}
 
+	return findManagedPrefixListEntries(conn, input)
+}
+
+func FindManagedPrefixListEntriesByIDAndVersion(conn *ec2.EC2, id string, version int64) ([]*ec2.PrefixListEntry, error) {
+	input := &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId:  aws.String(id),
+		TargetVersion: aws.Int64(version),
+	}
+
+	return findManagedPrefixListEntries(conn, input)
+}
+
+func findManagedPrefixListEntries(conn *ec2.EC2, input *ec2.GetManagedPrefixListEntriesInput) ([]*ec2.PrefixListEntry, error) {
 	var prefixListEntries []*ec2.PrefixListEntry
 
 	err := conn.GetManagedPrefixListEntriesPages(input, func(page *ec2.GetManagedPrefixListEntriesOutput, lastPage bool) bool {
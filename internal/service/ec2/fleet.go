@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,7 +29,14 @@ func ResourceFleet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// Instant fleets cannot be modified.
+			customdiff.ForceNewIf("excess_capacity_termination_policy", resourceFleetIsInstant),
+			customdiff.ForceNewIf("launch_template_config", resourceFleetIsInstant),
+			customdiff.ForceNewIf("target_capacity_specification.0.total_target_capacity", resourceFleetIsInstant),
+			resourceFleetCapacityReservationOptionsCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -133,6 +142,22 @@ func ResourceFleet() *schema.Resource {
 								ec2.FleetOnDemandAllocationStrategyPrioritized,
 							}, false),
 						},
+						"capacity_reservation_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"usage_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationUsageStrategy_Values(), false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -321,6 +346,7 @@ func ResourceFleet() *schema.Resource {
 				ForceNew: true,
 				Default:  ec2.FleetTypeMaintain,
 				ValidateFunc: validation.StringInSlice([]string{
+					ec2.FleetTypeInstant,
 					ec2.FleetTypeMaintain,
 					ec2.FleetTypeRequest,
 				}, false),
@@ -362,6 +388,13 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(output.FleetId))
 
+	// Instant fleets are fulfilled synchronously and report launch failures in the response.
+	if d.Get("type").(string) == ec2.FleetTypeInstant && len(output.Instances) == 0 && len(output.Errors) > 0 {
+		apiObject := output.Errors[0]
+
+		return fmt.Errorf("error creating EC2 Fleet (%s): %s: %s", d.Id(), aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage))
+	}
+
 	// If a request type is fulfilled immediately, we can miss the transition from active to deleted
 	// Instead of an error here, allow the Read function to trigger recreation
 	target := []string{ec2.FleetStateCodeActive}
@@ -492,6 +525,24 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	if d.HasChangesExcept("tags", "tags_all", "terminate_instances") {
+		if err := resourceFleetModify(d, conn); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourceFleetRead(d, meta)
+}
+
+func resourceFleetModify(d *schema.ResourceData, conn *ec2.EC2) error {
 	input := &ec2.ModifyFleetInput{
 		ExcessCapacityTerminationPolicy: aws.String(d.Get("excess_capacity_termination_policy").(string)),
 		LaunchTemplateConfigs:           expandEc2FleetLaunchTemplateConfigRequests(d.Get("launch_template_config").([]interface{})),
@@ -523,23 +574,17 @@ func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for EC2 Fleet (%s) modification: %s", d.Id(), err)
 	}
 
-	if d.HasChange("tags_all") {
-		o, n := d.GetChange("tags_all")
-
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
-		}
-	}
-
-	return resourceFleetRead(d, meta)
+	return nil
 }
 
 func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	terminateInstances := d.Get("terminate_instances").(bool)
+
 	input := &ec2.DeleteFleetsInput{
 		FleetIds:           []*string{aws.String(d.Id())},
-		TerminateInstances: aws.Bool(d.Get("terminate_instances").(bool)),
+		TerminateInstances: aws.Bool(terminateInstances),
 	}
 
 	log.Printf("[DEBUG] Deleting EC2 Fleet (%s): %s", d.Id(), input)
@@ -555,7 +600,7 @@ func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 
 	pending := []string{ec2.FleetStateCodeActive}
 	target := []string{ec2.FleetStateCodeDeleted}
-	if d.Get("terminate_instances").(bool) {
+	if terminateInstances {
 		pending = append(pending, ec2.FleetStateCodeDeletedTerminating)
 		// AWS SDK constant is incorrect: unexpected state 'deleted_terminating', wanted target 'deleted, deleted-terminating'
 		pending = append(pending, "deleted_terminating")
@@ -581,6 +626,24 @@ func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceFleetIsInstant(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.Get("type").(string) == ec2.FleetTypeInstant
+}
+
+func resourceFleetCapacityReservationOptionsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("type") {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("on_demand_options.0.capacity_reservation_options"); ok && len(v.([]interface{})) > 0 {
+		if fleetType := diff.Get("type").(string); fleetType != ec2.FleetTypeInstant {
+			return fmt.Errorf("on_demand_options capacity_reservation_options can only be set when type is %q, got %q", ec2.FleetTypeInstant, fleetType)
+		}
+	}
+
+	return nil
+}
+
 func ec2FleetRefreshFunc(conn *ec2.EC2, fleetID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &ec2.DescribeFleetsInput{
@@ -725,8 +788,25 @@ func expandEc2OnDemandOptionsRequest(l []interface{}) *ec2.OnDemandOptionsReques
 	m := l[0].(map[string]interface{})
 
 	return &ec2.OnDemandOptionsRequest{
-		AllocationStrategy: aws.String(m["allocation_strategy"].(string)),
+		AllocationStrategy:         aws.String(m["allocation_strategy"].(string)),
+		CapacityReservationOptions: expandEc2CapacityReservationOptionsRequest(m["capacity_reservation_options"].([]interface{})),
+	}
+}
+
+func expandEc2CapacityReservationOptionsRequest(l []interface{}) *ec2.CapacityReservationOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
 	}
+
+	m := l[0].(map[string]interface{})
+
+	capacityReservationOptionsRequest := &ec2.CapacityReservationOptionsRequest{}
+
+	if v, ok := m["usage_strategy"].(string); ok && v != "" {
+		capacityReservationOptionsRequest.UsageStrategy = aws.String(v)
+	}
+
+	return capacityReservationOptionsRequest
 }
 
 func expandEc2SpotOptionsRequest(l []interface{}) *ec2.SpotOptionsRequest {
@@ -866,7 +946,20 @@ func flattenEc2OnDemandOptions(onDemandOptions *ec2.OnDemandOptions) []interface
 	}
 
 	m := map[string]interface{}{
-		"allocation_strategy": aws.StringValue(onDemandOptions.AllocationStrategy),
+		"allocation_strategy":          aws.StringValue(onDemandOptions.AllocationStrategy),
+		"capacity_reservation_options": flattenEc2CapacityReservationOptions(onDemandOptions.CapacityReservationOptions),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEc2CapacityReservationOptions(capacityReservationOptions *ec2.CapacityReservationOptions) []interface{} {
+	if capacityReservationOptions == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"usage_strategy": aws.StringValue(capacityReservationOptions.UsageStrategy),
 	}
 
 	return []interface{}{m}
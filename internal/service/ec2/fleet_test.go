@@ -3,6 +3,7 @@ package ec2_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -629,6 +630,41 @@ func TestAccEC2Fleet_OnDemandOptions_allocationStrategy(t *testing.T) {
 	})
 }
 
+func TestAccEC2Fleet_OnDemandOptions_capacityReservationOptions(t *testing.T) {
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckFleet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_OnDemandOptions_CapacityReservationOptions(rName, "maintain", "use-capacity-reservations-first"),
+				ExpectError: regexp.MustCompile(`capacity_reservation_options can only be set when type is "instant"`),
+			},
+			{
+				Config: testAccFleetConfig_OnDemandOptions_CapacityReservationOptions(rName, "instant", "use-capacity-reservations-first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "type", "instant"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_options.0.capacity_reservation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_options.0.capacity_reservation_options.0.usage_strategy", "use-capacity-reservations-first"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_replaceUnhealthyInstances(t *testing.T) {
 	var fleet1, fleet2 ec2.FleetData
 	resourceName := "aws_ec2_fleet.test"
@@ -1760,6 +1796,51 @@ resource "aws_ec2_fleet" "test" {
 `, allocationStrategy)
 }
 
+func testAccFleetConfig_OnDemandOptions_CapacityReservationOptions(rName, fleetType, usageStrategy string) string {
+	return acctest.ConfigCompose(
+		testAccFleetConfig_BaseLaunchTemplate(rName),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_count    = 1
+  instance_platform = "Linux/UNIX"
+  instance_type     = aws_launch_template.test.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_fleet" "test" {
+  terminate_instances = true
+  type                = %[2]q
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+
+    override {
+      availability_zone = aws_ec2_capacity_reservation.test.availability_zone
+    }
+  }
+
+  on_demand_options {
+    capacity_reservation_options {
+      usage_strategy = %[3]q
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "on-demand"
+    total_target_capacity        = 1
+  }
+}
+`, rName, fleetType, usageStrategy))
+}
+
 func testAccFleetConfig_ReplaceUnhealthyInstances(rName string, replaceUnhealthyInstances bool) string {
 	return testAccFleetConfig_BaseLaunchTemplate(rName) + fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
@@ -3507,7 +3507,7 @@ func TestAccEC2Instance_hibernation(t *testing.T) {
 }
 
 func TestAccEC2Instance_metadataOptions(t *testing.T) {
-	var v ec2.Instance
+	var v1, v2 ec2.Instance
 	resourceName := "aws_instance.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -3520,7 +3520,7 @@ func TestAccEC2Instance_metadataOptions(t *testing.T) {
 			{
 				Config: testAccInstanceConfigMetadataOptions(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckInstanceExists(resourceName, &v),
+					testAccCheckInstanceExists(resourceName, &v1),
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.0.http_endpoint", "disabled"),
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.0.http_tokens", "optional"),
@@ -3531,7 +3531,8 @@ func TestAccEC2Instance_metadataOptions(t *testing.T) {
 			{
 				Config: testAccInstanceConfigMetadataOptionsUpdated(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckInstanceExists(resourceName, &v),
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckInstanceNotRecreated(&v1, &v2),
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.0.http_endpoint", "enabled"),
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.0.http_tokens", "required"),
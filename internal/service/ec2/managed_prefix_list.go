@@ -17,6 +17,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// managedPrefixListEntriesPerRequest is the maximum number of entries that can be added or removed in a single request.
+const managedPrefixListEntriesPerRequest = 100
+
 func ResourceManagedPrefixList() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceManagedPrefixListCreate,
@@ -102,8 +105,16 @@ func resourceManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) e
 		input.AddressFamily = aws.String(v.(string))
 	}
 
+	var entries []*ec2.AddPrefixListEntry
 	if v, ok := d.GetOk("entry"); ok && v.(*schema.Set).Len() > 0 {
-		input.Entries = expandEc2AddPrefixListEntries(v.(*schema.Set).List())
+		entries = expandEc2AddPrefixListEntries(v.(*schema.Set).List())
+
+		// Any entries above the per-request limit are added once the prefix list has been created.
+		if len(entries) > managedPrefixListEntriesPerRequest {
+			input.Entries = entries[:managedPrefixListEntriesPerRequest]
+		} else {
+			input.Entries = entries
+		}
 	}
 
 	if v, ok := d.GetOk("max_entries"); ok {
@@ -131,6 +142,12 @@ func resourceManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) create: %w", d.Id(), err)
 	}
 
+	if len(entries) > len(input.Entries) {
+		if err := updateManagedPrefixListEntries(conn, d.Id(), entries); err != nil {
+			return err
+		}
+	}
+
 	return resourceManagedPrefixListRead(d, meta)
 }
 
@@ -187,107 +204,48 @@ func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) err
 func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	// The size of a prefix list cannot be modified in the same request as its entries.
+	// Increase the size before the entries are modified and decrease it afterwards.
+	o, n := d.GetChange("max_entries")
+	increaseMaxEntries := d.HasChange("max_entries") && n.(int) > o.(int)
+	decreaseMaxEntries := d.HasChange("max_entries") && n.(int) < o.(int)
+
+	if d.HasChange("name") {
 		input := &ec2.ModifyManagedPrefixListInput{
-			PrefixListId: aws.String(d.Id()),
+			PrefixListId:   aws.String(d.Id()),
+			PrefixListName: aws.String(d.Get("name").(string)),
 		}
 
-		input.PrefixListName = aws.String(d.Get("name").(string))
-		currentVersion := int64(d.Get("version").(int))
-		wait := false
-
-		oldAttr, newAttr := d.GetChange("entry")
-		os := oldAttr.(*schema.Set)
-		ns := newAttr.(*schema.Set)
-
-		if addEntries := ns.Difference(os); addEntries.Len() > 0 {
-			input.AddEntries = expandEc2AddPrefixListEntries(addEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
+		if err := modifyManagedPrefixList(conn, input, false); err != nil {
+			return err
 		}
+	}
 
-		if removeEntries := os.Difference(ns); removeEntries.Len() > 0 {
-			input.RemoveEntries = expandEc2RemovePrefixListEntries(removeEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
+	if increaseMaxEntries {
+		input := &ec2.ModifyManagedPrefixListInput{
+			MaxEntries:   aws.Int64(int64(n.(int))),
+			PrefixListId: aws.String(d.Id()),
 		}
 
-		// Prevent the following error on description-only updates:
-		//   InvalidParameterValue: Request cannot contain Cidr #.#.#.#/# in both AddPrefixListEntries and RemovePrefixListEntries
-		// Attempting to just delete the RemoveEntries item causes:
-		//   InvalidRequest: The request received was invalid.
-		// Therefore it seems we must issue two ModifyManagedPrefixList calls,
-		// one with a collection of all description-only removals and the
-		// second one will add them all back.
-		if len(input.AddEntries) > 0 && len(input.RemoveEntries) > 0 {
-			descriptionOnlyRemovals := []*ec2.RemovePrefixListEntry{}
-			removals := []*ec2.RemovePrefixListEntry{}
-
-			for _, removeEntry := range input.RemoveEntries {
-				inAddAndRemove := false
-
-				for _, addEntry := range input.AddEntries {
-					if aws.StringValue(addEntry.Cidr) == aws.StringValue(removeEntry.Cidr) {
-						inAddAndRemove = true
-						break
-					}
-				}
-
-				if inAddAndRemove {
-					descriptionOnlyRemovals = append(descriptionOnlyRemovals, removeEntry)
-				} else {
-					removals = append(removals, removeEntry)
-				}
-			}
-
-			if len(descriptionOnlyRemovals) > 0 {
-				_, err := conn.ModifyManagedPrefixList(&ec2.ModifyManagedPrefixListInput{
-					CurrentVersion: input.CurrentVersion,
-					PrefixListId:   aws.String(d.Id()),
-					RemoveEntries:  descriptionOnlyRemovals,
-				})
-
-				if err != nil {
-					return fmt.Errorf("error updating EC2 Managed Prefix List (%s): %w", d.Id(), err)
-				}
-
-				managedPrefixList, err := WaitManagedPrefixListModified(conn, d.Id())
-
-				if err != nil {
-					return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", d.Id(), err)
-				}
-
-				if managedPrefixList == nil {
-					return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: empty response", d.Id())
-				}
-
-				input.CurrentVersion = managedPrefixList.Version
-			}
-
-			if len(removals) > 0 {
-				input.RemoveEntries = removals
-			} else {
-				// Prevent this error if RemoveEntries is list with no elements after removals:
-				//   InvalidRequest: The request received was invalid.
-				input.RemoveEntries = nil
-			}
+		if err := modifyManagedPrefixList(conn, input, true); err != nil {
+			return err
 		}
+	}
 
-		if d.HasChange("max_entries") {
-			input.MaxEntries = aws.Int64(int64(d.Get("max_entries").(int)))
-			wait = true
+	if d.HasChange("entry") {
+		if err := updateManagedPrefixListEntries(conn, d.Id(), expandEc2AddPrefixListEntries(d.Get("entry").(*schema.Set).List())); err != nil {
+			return err
 		}
+	}
 
-		_, err := conn.ModifyManagedPrefixList(input)
-
-		if err != nil {
-			return fmt.Errorf("error updating EC2 Managed Prefix List (%s): %w", d.Id(), err)
+	if decreaseMaxEntries {
+		input := &ec2.ModifyManagedPrefixListInput{
+			MaxEntries:   aws.Int64(int64(n.(int))),
+			PrefixListId: aws.String(d.Id()),
 		}
 
-		if wait {
-			if _, err := WaitManagedPrefixListModified(conn, d.Id()); err != nil {
-				return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", d.Id(), err)
-			}
+		if err := modifyManagedPrefixList(conn, input, true); err != nil {
+			return err
 		}
 	}
 
@@ -324,6 +282,148 @@ func resourceManagedPrefixListDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// updateManagedPrefixListEntries modifies the entries of a prefix list to match the desired entries.
+// The changes are computed against the current entries and applied in batches no larger than the per-request limit.
+// The version is read once and every request must apply to the version it follows, so that concurrent
+// modifications fail instead of being overwritten.
+func updateManagedPrefixListEntries(conn *ec2.EC2, id string, entries []*ec2.AddPrefixListEntry) error {
+	pl, err := FindManagedPrefixListByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Managed Prefix List (%s): %w", id, err)
+	}
+
+	version := aws.Int64Value(pl.Version)
+
+	prefixListEntries, err := FindManagedPrefixListEntriesByIDAndVersion(conn, id, version)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Managed Prefix List (%s) Entries: %w", id, err)
+	}
+
+	desired := make(map[string]*ec2.AddPrefixListEntry, len(entries))
+	for _, entry := range entries {
+		desired[aws.StringValue(entry.Cidr)] = entry
+	}
+
+	current := make(map[string]string, len(prefixListEntries))
+	var addEntries []*ec2.AddPrefixListEntry
+	var removeEntries, descriptionOnlyRemovals []*ec2.RemovePrefixListEntry
+
+	for _, prefixListEntry := range prefixListEntries {
+		cidr := aws.StringValue(prefixListEntry.Cidr)
+		current[cidr] = aws.StringValue(prefixListEntry.Description)
+
+		entry, ok := desired[cidr]
+
+		if !ok {
+			removeEntries = append(removeEntries, &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+			continue
+		}
+
+		// Prevent the following error on description-only updates:
+		//   InvalidParameterValue: Request cannot contain Cidr #.#.#.#/# in both AddPrefixListEntries and RemovePrefixListEntries
+		// Attempting to just delete the RemoveEntries item causes:
+		//   InvalidRequest: The request received was invalid.
+		// Therefore description-only changes are removed in separate
+		// ModifyManagedPrefixList calls before they are added back.
+		if aws.StringValue(entry.Description) != aws.StringValue(prefixListEntry.Description) {
+			descriptionOnlyRemovals = append(descriptionOnlyRemovals, &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+			addEntries = append(addEntries, entry)
+		}
+	}
+
+	for _, entry := range entries {
+		if _, ok := current[aws.StringValue(entry.Cidr)]; !ok {
+			addEntries = append(addEntries, entry)
+		}
+	}
+
+	for len(descriptionOnlyRemovals) > 0 {
+		n := len(descriptionOnlyRemovals)
+		if n > managedPrefixListEntriesPerRequest {
+			n = managedPrefixListEntriesPerRequest
+		}
+
+		input := &ec2.ModifyManagedPrefixListInput{
+			CurrentVersion: aws.Int64(version),
+			PrefixListId:   aws.String(id),
+			RemoveEntries:  descriptionOnlyRemovals[:n],
+		}
+
+		if err := modifyManagedPrefixList(conn, input, true); err != nil {
+			return err
+		}
+
+		// Each modification of the entries creates a new version.
+		version++
+
+		descriptionOnlyRemovals = descriptionOnlyRemovals[n:]
+	}
+
+	// Removals and additions are paired in each request so that the number
+	// of entries never exceeds the larger of the current and desired counts.
+	for len(addEntries) > 0 || len(removeEntries) > 0 {
+		input := &ec2.ModifyManagedPrefixListInput{
+			CurrentVersion: aws.Int64(version),
+			PrefixListId:   aws.String(id),
+		}
+
+		if n := len(addEntries); n > 0 {
+			if n > managedPrefixListEntriesPerRequest {
+				n = managedPrefixListEntriesPerRequest
+			}
+
+			input.AddEntries = addEntries[:n]
+			addEntries = addEntries[n:]
+		}
+
+		if n := len(removeEntries); n > 0 {
+			if n > managedPrefixListEntriesPerRequest {
+				n = managedPrefixListEntriesPerRequest
+			}
+
+			input.RemoveEntries = removeEntries[:n]
+			removeEntries = removeEntries[n:]
+		}
+
+		if err := modifyManagedPrefixList(conn, input, true); err != nil {
+			return err
+		}
+
+		version++
+	}
+
+	return nil
+}
+
+// modifyManagedPrefixList calls ModifyManagedPrefixList, retrying while a previous modification is still in progress.
+// Requests that modify entries must set CurrentVersion; if the prefix list has been modified since that version,
+// an error is returned rather than retrying against the new version and overwriting the concurrent changes.
+func modifyManagedPrefixList(conn *ec2.EC2, input *ec2.ModifyManagedPrefixListInput, wait bool) error {
+	id := aws.StringValue(input.PrefixListId)
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ManagedPrefixListTimeout, func() (interface{}, error) {
+		return conn.ModifyManagedPrefixList(input)
+	}, ErrCodeIncorrectState)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodePrefixListVersionMismatch) {
+		return fmt.Errorf("error updating EC2 Managed Prefix List (%s): modified concurrently since version %d: %w", id, aws.Int64Value(input.CurrentVersion), err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating EC2 Managed Prefix List (%s): %w", id, err)
+	}
+
+	if wait {
+		if _, err := WaitManagedPrefixListModified(conn, id); err != nil {
+			return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
 func expandEc2AddPrefixListEntry(tfMap map[string]interface{}) *ec2.AddPrefixListEntry {
 	if tfMap == nil {
 		return nil
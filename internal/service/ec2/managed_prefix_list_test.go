@@ -217,6 +217,53 @@ func TestAccEC2ManagedPrefixList_Entry_description(t *testing.T) {
 	})
 }
 
+func TestAccEC2ManagedPrefixList_Entry_batched(t *testing.T) {
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckManagedPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:       testAccManagedPrefixListConfig_Entry_Batched(rName, 200, 0, 150),
+				ResourceName: resourceName,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "150"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "200"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"), // entries above the per-request limit are added after creation
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:       testAccManagedPrefixListConfig_Entry_Batched(rName, 200, 25, 175),
+				ResourceName: resourceName,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "150"),
+					resource.TestCheckResourceAttr(resourceName, "version", "3"), // additions and removals share a single request
+				),
+			},
+			{
+				Config:       testAccManagedPrefixListConfig_Entry_Batched(rName, 300, 0, 250),
+				ResourceName: resourceName,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "250"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "300"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2ManagedPrefixList_name(t *testing.T) {
 	resourceName := "aws_ec2_managed_prefix_list.test"
 	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -435,6 +482,25 @@ resource "aws_ec2_managed_prefix_list" "test" {
 `, rName, description)
 }
 
+func testAccManagedPrefixListConfig_Entry_Batched(rName string, maxEntries, start, end int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = %[2]d
+  name           = %[1]q
+
+  dynamic "entry" {
+    for_each = range(%[3]d, %[4]d)
+
+    content {
+      cidr        = cidrsubnet("10.0.0.0/8", 16, entry.value)
+      description = "Entry ${entry.value}"
+    }
+  }
+}
+`, rName, maxEntries, start, end)
+}
+
 func testAccManagedPrefixListConfig_Name(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_managed_prefix_list" "test" {
@@ -147,6 +147,11 @@ func ResourceSecurityGroupRule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validSecurityGroupRuleDescription,
 			},
+
+			"security_group_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -329,7 +334,20 @@ func resourceSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) err
 
 	setFromIPPerm(d, sg, p)
 
-	d.Set("description", descriptionFromIPPerm(d, rule))
+	securityGroupRule, err := findSecurityGroupRuleForIPPerm(conn, d, sg, ruleType, p)
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s) Rule (%s): %w", sg_id, d.Id(), err)
+	}
+
+	// Prefer the description of the matching security group rule, as the
+	// description on the IP permission is ambiguous when rules are merged.
+	if securityGroupRule != nil {
+		d.Set("description", securityGroupRule.Description)
+		d.Set("security_group_rule_id", securityGroupRule.SecurityGroupRuleId)
+	} else {
+		d.Set("description", descriptionFromIPPerm(d, rule))
+		d.Set("security_group_rule_id", "")
+	}
 
 	if strings.Contains(d.Id(), "_") {
 		// import so fix the id
@@ -512,6 +530,86 @@ func findRuleMatch(p *ec2.IpPermission, rules []*ec2.IpPermission, isVPC bool) *
 	return rule
 }
 
+// findSecurityGroupRuleForIPPerm returns the security group rule corresponding to an
+// IP permission with a single source or destination, or nil if there is none.
+// A previously read security group rule ID is looked up directly.
+func findSecurityGroupRuleForIPPerm(conn *ec2.EC2, d *schema.ResourceData, sg *ec2.SecurityGroup, ruleType string, p *ec2.IpPermission) (*ec2.SecurityGroupRule, error) {
+	// Security group rule IDs are only available for VPC security groups.
+	if aws.StringValue(sg.VpcId) == "" {
+		return nil, nil
+	}
+
+	if len(p.IpRanges)+len(p.Ipv6Ranges)+len(p.PrefixListIds)+len(p.UserIdGroupPairs) != 1 {
+		return nil, nil
+	}
+
+	groupID := aws.StringValue(sg.GroupId)
+	isEgress := ruleType == "egress"
+
+	if v, ok := d.GetOk("security_group_rule_id"); ok {
+		securityGroupRule, err := FindSecurityGroupRuleByID(conn, v.(string))
+
+		if err == nil && aws.StringValue(securityGroupRule.GroupId) == groupID && securityGroupRuleMatchesIPPerm(securityGroupRule, isEgress, p) {
+			return securityGroupRule, nil
+		}
+
+		if err != nil && !tfresource.NotFound(err) {
+			return nil, err
+		}
+
+		// The rule has been replaced outside of Terraform; fall back to matching on the rule tuple.
+	}
+
+	securityGroupRules, err := FindSecurityGroupRules(conn, &ec2.DescribeSecurityGroupRulesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"group-id": groupID,
+		}),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, securityGroupRule := range securityGroupRules {
+		if securityGroupRuleMatchesIPPerm(securityGroupRule, isEgress, p) {
+			return securityGroupRule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func securityGroupRuleMatchesIPPerm(r *ec2.SecurityGroupRule, isEgress bool, p *ec2.IpPermission) bool {
+	if aws.BoolValue(r.IsEgress) != isEgress {
+		return false
+	}
+
+	if aws.StringValue(r.IpProtocol) != aws.StringValue(p.IpProtocol) {
+		return false
+	}
+
+	if p.FromPort != nil && aws.Int64Value(r.FromPort) != aws.Int64Value(p.FromPort) {
+		return false
+	}
+
+	if p.ToPort != nil && aws.Int64Value(r.ToPort) != aws.Int64Value(p.ToPort) {
+		return false
+	}
+
+	switch {
+	case len(p.IpRanges) == 1:
+		return aws.StringValue(r.CidrIpv4) == aws.StringValue(p.IpRanges[0].CidrIp)
+	case len(p.Ipv6Ranges) == 1:
+		return aws.StringValue(r.CidrIpv6) == aws.StringValue(p.Ipv6Ranges[0].CidrIpv6)
+	case len(p.PrefixListIds) == 1:
+		return aws.StringValue(r.PrefixListId) == aws.StringValue(p.PrefixListIds[0].PrefixListId)
+	case len(p.UserIdGroupPairs) == 1:
+		return r.ReferencedGroupInfo != nil && aws.StringValue(r.ReferencedGroupInfo.GroupId) == aws.StringValue(p.UserIdGroupPairs[0].GroupId)
+	}
+
+	return false
+}
+
 func IPPermissionIDHash(sg_id, ruleType string, ip *ec2.IpPermission) string {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s-", sg_id))
@@ -1037,6 +1037,53 @@ func TestAccEC2SecurityGroupRule_MultipleRuleSearching_allProtocolCrash(t *testi
 	})
 }
 
+func TestAccEC2SecurityGroupRule_Description_sharedTuple(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	securityGroupResourceName := "aws_security_group.test"
+	resource1Name := "aws_security_group_rule.test1"
+	resource2Name := "aws_security_group_rule.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityGroupRuleDescriptionSharedTupleConfig(rName, "description1", "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRuleExists(securityGroupResourceName, &group),
+					resource.TestCheckResourceAttr(resource1Name, "description", "description1"),
+					resource.TestMatchResourceAttr(resource1Name, "security_group_rule_id", regexp.MustCompile(`^sgr-[a-z0-9]+$`)),
+					resource.TestCheckResourceAttr(resource2Name, "description", "description2"),
+					resource.TestMatchResourceAttr(resource2Name, "security_group_rule_id", regexp.MustCompile(`^sgr-[a-z0-9]+$`)),
+				),
+			},
+			{
+				ResourceName:      resource1Name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSecurityGroupRuleImportStateIdFunc(resource1Name),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resource2Name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSecurityGroupRuleImportStateIdFunc(resource2Name),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityGroupRuleDescriptionSharedTupleConfig(rName, "description2", "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRuleExists(securityGroupResourceName, &group),
+					resource.TestCheckResourceAttr(resource1Name, "description", "description2"),
+					resource.TestCheckResourceAttr(resource2Name, "description", "description1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2SecurityGroupRule_multiDescription(t *testing.T) {
 	var group ec2.SecurityGroup
 	var nat ec2.SecurityGroup
@@ -2047,6 +2094,38 @@ resource "aws_security_group_rule" "test" {
 `, rName, description)
 }
 
+func testAccSecurityGroupRuleDescriptionSharedTupleConfig(rName, description1, description2 string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group_rule" "test1" {
+  cidr_blocks       = ["10.0.0.0/16"]
+  description       = %[2]q
+  from_port         = 443
+  protocol          = "tcp"
+  security_group_id = aws_security_group.test.id
+  to_port           = 443
+  type              = "ingress"
+}
+
+resource "aws_security_group_rule" "test2" {
+  cidr_blocks       = ["10.1.0.0/16"]
+  description       = %[3]q
+  from_port         = 443
+  protocol          = "tcp"
+  security_group_id = aws_security_group.test.id
+  to_port           = 443
+  type              = "ingress"
+}
+`, rName, description1, description2)
+}
+
 func testAccSecurityGroupRuleMultipleRuleSearchingAllProtocolCrashConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
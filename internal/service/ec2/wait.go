@@ -971,6 +971,28 @@ func WaitCustomerGatewayDeleted(conn *ec2.EC2, id string) (*ec2.CustomerGateway,
 	return nil, err
 }
 
+const (
+	capacityBlockReservationPurchasedTimeout = 40 * time.Minute
+)
+
+// WaitCapacityBlockReservationPurchased waits for payment of a purchased Capacity Block to complete.
+func WaitCapacityBlockReservationPurchased(conn *ec2.EC2, id string) (*ec2.CapacityReservation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationStatePaymentPending},
+		Target:  []string{ec2.CapacityReservationStateScheduled, ec2.CapacityReservationStateActive},
+		Refresh: StatusCapacityReservationState(conn, id),
+		Timeout: capacityBlockReservationPurchasedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	natGatewayCreatedTimeout = 10 * time.Minute
 	natGatewayDeletedTimeout = 30 * time.Minute
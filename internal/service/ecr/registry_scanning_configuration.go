@@ -1,6 +1,7 @@
 package ecr
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -22,6 +23,8 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceRegistryScanningConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -84,7 +87,7 @@ func resourceRegistryScanningConfigurationPut(d *schema.ResourceData, meta inter
 	_, err := conn.PutRegistryScanningConfiguration(&input)
 
 	if err != nil {
-		return fmt.Errorf("error creating ECR Registry Scanning Configuration: %w", err)
+		return fmt.Errorf("error putting ECR Registry Scanning Configuration: %w", err)
 	}
 
 	d.SetId(meta.(*conns.AWSClient).AccountID)
@@ -101,9 +104,16 @@ func resourceRegistryScanningConfigurationRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
 	}
 
+	if out == nil || out.ScanningConfiguration == nil {
+		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): empty output", d.Id())
+	}
+
 	d.Set("registry_id", out.RegistryId)
 	d.Set("scan_type", out.ScanningConfiguration.ScanType)
-	d.Set("rule", flattenEcrScanningConfigurationRules(out.ScanningConfiguration.Rules))
+
+	if err := d.Set("rule", flattenEcrScanningConfigurationRules(out.ScanningConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
 
 	return nil
 }
@@ -124,6 +134,32 @@ func resourceRegistryScanningConfigurationDelete(d *schema.ResourceData, meta in
 	return nil
 }
 
+// resourceRegistryScanningConfigurationCustomizeDiff validates that each rule's scan frequency
+// is supported by the registry's scan type. Enhanced scanning (provided by Amazon Inspector)
+// supports SCAN_ON_PUSH and CONTINUOUS_SCAN, basic scanning supports SCAN_ON_PUSH and MANUAL.
+func resourceRegistryScanningConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	scanType := diff.Get("scan_type").(string)
+
+	for _, v := range diff.Get("rule").(*schema.Set).List() {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		scanFrequency := tfMap["scan_frequency"].(string)
+
+		switch {
+		case scanType == ecr.ScanTypeBasic && scanFrequency == ecr.ScanFrequencyContinuousScan:
+			return fmt.Errorf("scan_frequency %q requires scan_type %q", scanFrequency, ecr.ScanTypeEnhanced)
+		case scanType == ecr.ScanTypeEnhanced && scanFrequency == ecr.ScanFrequencyManual:
+			return fmt.Errorf("scan_frequency %q is not supported with scan_type %q", scanFrequency, ecr.ScanTypeEnhanced)
+		}
+	}
+
+	return nil
+}
+
 // Helper functions
 
 func expandEcrScanningRegistryRules(l []interface{}) []*ecr.RegistryScanningRule {
@@ -2,6 +2,7 @@ package ecr_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -13,8 +14,10 @@ import (
 
 func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	testFuncs := map[string]func(t *testing.T){
-		"basic":  testAccRegistryScanningConfiguration_basic,
-		"update": testAccRegistryScanningConfiguration_update,
+		"basic":                   testAccRegistryScanningConfiguration_basic,
+		"update":                  testAccRegistryScanningConfiguration_update,
+		"enhanced":                testAccRegistryScanningConfiguration_enhanced,
+		"invalidFrequencyForType": testAccRegistryScanningConfiguration_invalidFrequencyForType,
 	}
 
 	for name, testFunc := range testFuncs {
@@ -111,6 +114,75 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	})
 }
 
+func testAccRegistryScanningConfiguration_enhanced(t *testing.T) {
+	var v ecr.GetRegistryScanningConfigurationOutput
+	resourceName := "aws_ecr_registry_scanning_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccRegistryScanningConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryScanningConfigurationConfigEnhanced("CONTINUOUS_SCAN", "prod-*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegistryScanningConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"scan_frequency":      "CONTINUOUS_SCAN",
+						"repository_filter.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.repository_filter.*", map[string]string{
+						"filter":      "prod-*",
+						"filter_type": "WILDCARD",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "ENHANCED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegistryScanningConfigurationConfigEnhanced("SCAN_ON_PUSH", "dev-*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegistryScanningConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"scan_frequency": "SCAN_ON_PUSH",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.repository_filter.*", map[string]string{
+						"filter":      "dev-*",
+						"filter_type": "WILDCARD",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "ENHANCED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegistryScanningConfiguration_invalidFrequencyForType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccRegistryScanningConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfigFrequency("BASIC", "CONTINUOUS_SCAN"),
+				ExpectError: regexp.MustCompile(`requires scan_type "ENHANCED"`),
+			},
+			{
+				Config:      testAccRegistryScanningConfigurationConfigFrequency("ENHANCED", "MANUAL"),
+				ExpectError: regexp.MustCompile(`is not supported with scan_type "ENHANCED"`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfigurationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
 
@@ -198,3 +270,33 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 }
 `
 }
+
+func testAccRegistryScanningConfigurationConfigEnhanced(scanFrequency, filter string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "ENHANCED"
+  rule {
+    scan_frequency = %[1]q
+    repository_filter {
+      filter      = %[2]q
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`, scanFrequency, filter)
+}
+
+func testAccRegistryScanningConfigurationConfigFrequency(scanType, scanFrequency string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = %[1]q
+  rule {
+    scan_frequency = %[2]q
+    repository_filter {
+      filter      = "*"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`, scanType, scanFrequency)
+}
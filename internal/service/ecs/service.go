@@ -41,6 +41,28 @@ func ResourceService() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"alarms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"rollback": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 			"capacity_provider_strategy": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -473,6 +495,14 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.DeploymentConfiguration.DeploymentCircuitBreaker = expandDeploymentCircuitBreaker(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		input.DeploymentConfiguration.Alarms = expandDeploymentAlarms(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("cluster"); ok {
 		input.Cluster = aws.String(v.(string))
 	}
@@ -720,6 +750,15 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			d.Set("deployment_circuit_breaker", nil)
 		}
+
+		// Disabled deployment alarms may be returned without any alarm names or omitted entirely.
+		if v := service.DeploymentConfiguration.Alarms; v != nil && (aws.BoolValue(v.Enable) || len(v.AlarmNames) > 0) {
+			if err := d.Set("alarms", []interface{}{flattenDeploymentAlarms(v)}); err != nil {
+				return fmt.Errorf("error setting alarms: %w", err)
+			}
+		} else {
+			d.Set("alarms", nil)
+		}
 	}
 
 	if err := d.Set("deployment_controller", flattenDeploymentController(service.DeploymentController)); err != nil {
@@ -824,6 +863,34 @@ func flattenDeploymentCircuitBreaker(apiObject *ecs.DeploymentCircuitBreaker) ma
 	return tfMap
 }
 
+func expandDeploymentAlarms(tfMap map[string]interface{}) *ecs.DeploymentAlarms {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.DeploymentAlarms{}
+
+	apiObject.AlarmNames = flex.ExpandStringSet(tfMap["alarm_names"].(*schema.Set))
+	apiObject.Enable = aws.Bool(tfMap["enable"].(bool))
+	apiObject.Rollback = aws.Bool(tfMap["rollback"].(bool))
+
+	return apiObject
+}
+
+func flattenDeploymentAlarms(apiObject *ecs.DeploymentAlarms) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	tfMap["alarm_names"] = aws.StringValueSlice(apiObject.AlarmNames)
+	tfMap["enable"] = aws.BoolValue(apiObject.Enable)
+	tfMap["rollback"] = aws.BoolValue(apiObject.Rollback)
+
+	return tfMap
+}
+
 func flattenNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -1045,6 +1112,25 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("alarms") {
+		updateService = true
+
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		// To remove existing deployment alarms, disable them without any alarm names.
+		input.DeploymentConfiguration.Alarms = &ecs.DeploymentAlarms{
+			AlarmNames: []*string{},
+			Enable:     aws.Bool(false),
+			Rollback:   aws.Bool(false),
+		}
+
+		if v, ok := d.GetOk("alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DeploymentConfiguration.Alarms = expandDeploymentAlarms(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if d.HasChange("ordered_placement_strategy") {
 		updateService = true
 		// Reference: https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_UpdateService.html#ECS-UpdateService-request-placementStrategy
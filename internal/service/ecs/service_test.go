@@ -555,6 +555,49 @@ func TestAccECSService_deploymentCircuitBreaker(t *testing.T) {
 	})
 }
 
+func TestAccECSService_alarms(t *testing.T) {
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAlarmsConfig(rName, "aws_cloudwatch_metric_alarm.test[0].alarm_name", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.alarm_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "alarms.0.alarm_names.*", "aws_cloudwatch_metric_alarm.test.0", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.rollback", "true"),
+				),
+			},
+			{
+				Config: testAccServiceAlarmsConfig(rName, "aws_cloudwatch_metric_alarm.test[0].alarm_name, aws_cloudwatch_metric_alarm.test[1].alarm_name", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.alarm_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.rollback", "false"),
+				),
+			},
+			{
+				Config: testAccServiceAlarmsRemovedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "alarms.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/3444
 func TestAccECSService_loadBalancerChanges(t *testing.T) {
 	var service ecs.Service
@@ -4033,6 +4076,75 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccServiceAlarmsBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  count = 2
+
+  alarm_name          = "%[1]s-${count.index}"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/ECS"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+
+  dimensions = {
+    ClusterName = aws_ecs_cluster.test.name
+  }
+}
+`, rName)
+}
+
+func testAccServiceAlarmsConfig(rName, alarmNames string, rollback bool) string {
+	return acctest.ConfigCompose(testAccServiceAlarmsBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ecs_service" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  desired_count   = 1
+  name            = %[1]q
+  task_definition = aws_ecs_task_definition.test.arn
+
+  alarms {
+    alarm_names = [%[2]s]
+    enable      = true
+    rollback    = %[3]t
+  }
+}
+`, rName, alarmNames, rollback))
+}
+
+func testAccServiceAlarmsRemovedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccServiceAlarmsBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ecs_service" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  desired_count   = 1
+  name            = %[1]q
+  task_definition = aws_ecs_task_definition.test.arn
+}
+`, rName))
+}
+
 func testAccServiceTags1Config(rName, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -1,6 +1,7 @@
 package elasticache
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 )
 
@@ -23,3 +24,118 @@ func flattenSecurityGroupNames(securityGroups []*elasticache.CacheSecurityGroupM
 	}
 	return result
 }
+
+func expandLogDeliveryConfigurations(tfList []interface{}) []*elasticache.LogDeliveryConfigurationRequest {
+	var apiObjects []*elasticache.LogDeliveryConfigurationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &elasticache.LogDeliveryConfigurationRequest{
+			DestinationDetails: &elasticache.DestinationDetails{},
+			Enabled:            aws.Bool(true),
+		}
+
+		if v, ok := tfMap["log_type"].(string); ok && v != "" {
+			apiObject.LogType = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_format"].(string); ok && v != "" {
+			apiObject.LogFormat = aws.String(v)
+		}
+
+		if v, ok := tfMap["destination_type"].(string); ok && v != "" {
+			apiObject.DestinationType = aws.String(v)
+
+			switch v {
+			case elasticache.DestinationTypeCloudwatchLogs:
+				apiObject.DestinationDetails.CloudWatchLogsDetails = &elasticache.CloudWatchLogsDestinationDetails{
+					LogGroup: aws.String(tfMap["destination"].(string)),
+				}
+			case elasticache.DestinationTypeKinesisFirehose:
+				apiObject.DestinationDetails.KinesisFirehoseDetails = &elasticache.KinesisFirehoseDestinationDetails{
+					DeliveryStream: aws.String(tfMap["destination"].(string)),
+				}
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandLogDeliveryConfigurationsUpdate returns the requests needed to move from the old to the new
+// configuration. Log types that are no longer configured must be explicitly disabled.
+func expandLogDeliveryConfigurationsUpdate(o, n []interface{}) []*elasticache.LogDeliveryConfigurationRequest {
+	apiObjects := expandLogDeliveryConfigurations(n)
+
+	configured := make(map[string]bool)
+	for _, apiObject := range apiObjects {
+		configured[aws.StringValue(apiObject.LogType)] = true
+	}
+
+	for _, tfMapRaw := range o {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		logType := tfMap["log_type"].(string)
+
+		if configured[logType] {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &elasticache.LogDeliveryConfigurationRequest{
+			Enabled: aws.Bool(false),
+			LogType: aws.String(logType),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLogDeliveryConfigurations(apiObjects []*elasticache.LogDeliveryConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		// Configurations that are being removed are still returned until disabling completes.
+		if aws.StringValue(apiObject.Status) == elasticache.LogDeliveryConfigurationStatusDisabling {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"destination_type": aws.StringValue(apiObject.DestinationType),
+			"log_format":       aws.StringValue(apiObject.LogFormat),
+			"log_type":         aws.StringValue(apiObject.LogType),
+		}
+
+		if v := apiObject.DestinationDetails; v != nil {
+			if v.CloudWatchLogsDetails != nil {
+				tfMap["destination"] = aws.StringValue(v.CloudWatchLogsDetails.LogGroup)
+			}
+
+			if v.KinesisFirehoseDetails != nil {
+				tfMap["destination"] = aws.StringValue(v.KinesisFirehoseDetails.DeliveryStream)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
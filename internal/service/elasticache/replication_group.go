@@ -144,6 +144,34 @@ func ResourceReplicationGroup() *schema.Resource {
 					"snapshot_name",
 				},
 			},
+			"log_delivery_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"destination_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(elasticache.DestinationType_Values(), false),
+						},
+						"log_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(elasticache.LogFormat_Values(), false),
+						},
+						"log_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(elasticache.LogType_Values(), false),
+						},
+					},
+				},
+			},
 			"maintenance_window": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -316,6 +344,7 @@ func ResourceReplicationGroup() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			CustomizeDiffValidateReplicationGroupAutomaticFailover,
 			CustomizeDiffElastiCacheEngineVersion,
+			CustomizeDiffValidateReplicationGroupLogDeliveryConfiguration,
 			customdiff.ComputedIf("member_clusters", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("number_cache_clusters") ||
 					diff.HasChange("cluster_mode.0.num_node_groups") ||
@@ -448,6 +477,10 @@ func resourceReplicationGroupCreate(d *schema.ResourceData, meta interface{}) er
 		params.UserGroupIds = flex.ExpandStringSet(userGroupIds)
 	}
 
+	if v, ok := d.GetOk("log_delivery_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		params.LogDeliveryConfigurations = expandLogDeliveryConfigurations(v.(*schema.Set).List())
+	}
+
 	resp, err := conn.CreateReplicationGroup(params)
 	if err != nil {
 		return fmt.Errorf("error creating ElastiCache Replication Group (%s): %w", d.Get("replication_group_id").(string), err)
@@ -534,6 +567,10 @@ func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("arn", rgp.ARN)
 	d.Set("data_tiering_enabled", aws.StringValue(rgp.DataTiering) == elasticache.DataTieringStatusEnabled)
 
+	if err := d.Set("log_delivery_configuration", flattenLogDeliveryConfigurations(rgp.LogDeliveryConfigurations)); err != nil {
+		return fmt.Errorf("error setting log_delivery_configuration: %w", err)
+	}
+
 	// Tags cannot be read when the replication group is not Available
 	_, err = WaitReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
@@ -596,7 +633,11 @@ func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("user_group_ids", rgp.UserGroupIds)
 
 		d.Set("at_rest_encryption_enabled", c.AtRestEncryptionEnabled)
-		d.Set("auto_minor_version_upgrade", c.AutoMinorVersionUpgrade)
+		if rgp.AutoMinorVersionUpgrade != nil {
+			d.Set("auto_minor_version_upgrade", rgp.AutoMinorVersionUpgrade)
+		} else {
+			d.Set("auto_minor_version_upgrade", c.AutoMinorVersionUpgrade)
+		}
 		d.Set("transit_encryption_enabled", c.TransitEncryptionEnabled)
 
 		if c.AuthTokenEnabled != nil && !aws.BoolValue(c.AuthTokenEnabled) {
@@ -657,6 +698,12 @@ func resourceReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("log_delivery_configuration") {
+		o, n := d.GetChange("log_delivery_configuration")
+		params.LogDeliveryConfigurations = expandLogDeliveryConfigurationsUpdate(o.(*schema.Set).List(), n.(*schema.Set).List())
+		requestUpdate = true
+	}
+
 	if d.HasChange("maintenance_window") {
 		params.PreferredMaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
 		requestUpdate = true
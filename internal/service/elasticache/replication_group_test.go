@@ -1955,6 +1955,92 @@ func TestAccElastiCacheReplicationGroup_dataTiering(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheReplicationGroup_logDeliveryConfigurations(t *testing.T) {
+	var rg elasticache.ReplicationGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationGroupConfigLogDeliveryConfigurations(rName, "json", "text"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"destination":      rName,
+						"destination_type": "kinesis-firehose",
+						"log_format":       "json",
+						"log_type":         "slow-log",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"destination":      rName,
+						"destination_type": "cloudwatch-logs",
+						"log_format":       "text",
+						"log_type":         "engine-log",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+			{
+				Config: testAccReplicationGroupConfigLogDeliveryConfigurations(rName, "text", "json"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"destination_type": "kinesis-firehose",
+						"log_format":       "text",
+						"log_type":         "slow-log",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"destination_type": "cloudwatch-logs",
+						"log_format":       "json",
+						"log_type":         "engine-log",
+					}),
+				),
+			},
+			{
+				Config: testAccReplicationGroupConfigLogDeliveryConfigurationsSlowLogOnly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"destination_type": "kinesis-firehose",
+						"log_format":       "json",
+						"log_type":         "slow-log",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheReplicationGroup_Validation_logDeliveryConfigurationEngineVersion(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReplicationGroupConfigLogDeliveryConfigurationsEngineVersion(rName, "5.0.6"),
+				ExpectError: regexp.MustCompile(`log_type "engine-log" requires Redis engine version 6.2.0 or later, got 5.0.6`),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationGroupExists(n string, v *elasticache.ReplicationGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -3456,6 +3542,140 @@ resource "aws_elasticache_replication_group" "test" {
 `, rName)
 }
 
+func testAccReplicationGroupConfigLogDeliveryConfigurationsBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "firehose.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:AbortMultipartUpload",
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:ListBucketMultipartUploads",
+        "s3:PutObject",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  name        = %[1]q
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.test.arn
+    bucket_arn = aws_s3_bucket.test.arn
+  }
+
+  tags = {
+    LogDeliveryEnabled = "true"
+  }
+}
+`, rName)
+}
+
+func testAccReplicationGroupConfigLogDeliveryConfigurations(rName, slowLogFormat, engineLogFormat string) string {
+	return acctest.ConfigCompose(testAccReplicationGroupConfigLogDeliveryConfigurationsBase(rName), fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  engine_version                = "6.x"
+  apply_immediately             = true
+
+  log_delivery_configuration {
+    destination      = aws_kinesis_firehose_delivery_stream.test.name
+    destination_type = "kinesis-firehose"
+    log_format       = %[2]q
+    log_type         = "slow-log"
+  }
+
+  log_delivery_configuration {
+    destination      = aws_cloudwatch_log_group.test.name
+    destination_type = "cloudwatch-logs"
+    log_format       = %[3]q
+    log_type         = "engine-log"
+  }
+}
+`, rName, slowLogFormat, engineLogFormat))
+}
+
+func testAccReplicationGroupConfigLogDeliveryConfigurationsSlowLogOnly(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationGroupConfigLogDeliveryConfigurationsBase(rName), fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  engine_version                = "6.x"
+  apply_immediately             = true
+
+  log_delivery_configuration {
+    destination      = aws_kinesis_firehose_delivery_stream.test.name
+    destination_type = "kinesis-firehose"
+    log_format       = "json"
+    log_type         = "slow-log"
+  }
+}
+`, rName))
+}
+
+func testAccReplicationGroupConfigLogDeliveryConfigurationsEngineVersion(rName, engineVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  engine_version                = %[2]q
+  apply_immediately             = true
+
+  log_delivery_configuration {
+    destination      = "test"
+    destination_type = "cloudwatch-logs"
+    log_format       = "json"
+    log_type         = "engine-log"
+  }
+}
+`, rName, engineVersion)
+}
+
 func resourceReplicationGroupDisableAutomaticFailover(conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) error {
 	return resourceReplicationGroupModify(conn, timeout, &elasticache.ModifyReplicationGroupInput{
 		ReplicationGroupId:       aws.String(replicationGroupID),
@@ -153,3 +153,58 @@ func CustomizeDiffValidateReplicationGroupAutomaticFailover(_ context.Context, d
 	}
 	return nil
 }
+
+// CustomizeDiffValidateReplicationGroupLogDeliveryConfiguration validates that the configured `engine_version` supports the configured log types
+func CustomizeDiffValidateReplicationGroupLogDeliveryConfiguration(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	engineVersion := diff.Get("engine_version").(string)
+
+	// For an existing replication group the actual engine version is known, e.g. 6.0.5 when "6.x" is configured.
+	if v := diff.Get("engine_version_actual").(string); v != "" && !diff.HasChange("engine_version") {
+		engineVersion = v
+	}
+
+	// The latest engine version is used when none is configured.
+	if engineVersion == "" || !diff.NewValueKnown("engine_version") {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("log_delivery_configuration").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		logType := tfMap["log_type"].(string)
+		minimumVersion, ok := logDeliveryMinimumRedisVersions[logType]
+
+		if !ok {
+			continue
+		}
+
+		// "<major>.x" resolves to the latest minor version of that major version.
+		if matches := redisVersionPostV6Regexp.FindStringSubmatch(engineVersion); matches != nil {
+			if major, err := gversion.NewVersion(matches[1]); err == nil && major.Segments()[0] >= minimumVersion.Segments()[0] {
+				continue
+			}
+		}
+
+		version, err := NormalizeElastiCacheEngineVersion(engineVersion)
+
+		if err != nil {
+			return fmt.Errorf("error parsing engine_version: %w", err)
+		}
+
+		if version.LessThan(minimumVersion) {
+			return fmt.Errorf("log_delivery_configuration with log_type %q requires Redis engine version %s or later, got %s", logType, minimumVersion, engineVersion)
+		}
+	}
+
+	return nil
+}
+
+// logDeliveryMinimumRedisVersions is the earliest Redis engine version that supports each log type
+var logDeliveryMinimumRedisVersions = map[string]*gversion.Version{
+	elasticache.LogTypeSlowLog:   gversion.Must(gversion.NewVersion("6.0.0")),
+	elasticache.LogTypeEngineLog: gversion.Must(gversion.NewVersion("6.2.0")),
+}
@@ -0,0 +1,6 @@
+package elbv2
+
+const (
+	// IpAddressTypeDualstackWithoutPublicIpv4 is not yet defined in the AWS SDK for Go.
+	IpAddressTypeDualstackWithoutPublicIpv4 = "dualstack-without-public-ipv4"
+)
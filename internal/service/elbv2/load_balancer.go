@@ -36,6 +36,7 @@ func ResourceLoadBalancer() *schema.Resource {
 		// Subnets are ForceNew for Network Load Balancers
 		CustomizeDiff: customdiff.Sequence(
 			customizeDiffNLBSubnets,
+			customizeDiffConnectionLogs,
 			verify.SetTagsDiff,
 		),
 		Importer: &schema.ResourceImporter{
@@ -186,6 +187,36 @@ func ResourceLoadBalancer() *schema.Resource {
 				},
 			},
 
+			"connection_logs": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return !d.Get("connection_logs.0.enabled").(bool)
+							},
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return !d.Get("connection_logs.0.enabled").(bool)
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"enable_deletion_protection": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -234,6 +265,7 @@ func ResourceLoadBalancer() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					elbv2.IpAddressTypeIpv4,
 					elbv2.IpAddressTypeDualstack,
+					IpAddressTypeDualstackWithoutPublicIpv4,
 				}, false),
 			},
 
@@ -469,6 +501,38 @@ func resourceLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error
 
 	switch d.Get("load_balancer_type").(string) {
 	case elbv2.LoadBalancerTypeEnumApplication:
+		if d.HasChange("connection_logs") {
+			logs := d.Get("connection_logs").([]interface{})
+
+			if len(logs) == 1 && logs[0] != nil {
+				log := logs[0].(map[string]interface{})
+
+				enabled := log["enabled"].(bool)
+
+				attributes = append(attributes,
+					&elbv2.LoadBalancerAttribute{
+						Key:   aws.String("connection_logs.s3.enabled"),
+						Value: aws.String(strconv.FormatBool(enabled)),
+					})
+				if enabled {
+					attributes = append(attributes,
+						&elbv2.LoadBalancerAttribute{
+							Key:   aws.String("connection_logs.s3.bucket"),
+							Value: aws.String(log["bucket"].(string)),
+						},
+						&elbv2.LoadBalancerAttribute{
+							Key:   aws.String("connection_logs.s3.prefix"),
+							Value: aws.String(log["prefix"].(string)),
+						})
+				}
+			} else {
+				attributes = append(attributes, &elbv2.LoadBalancerAttribute{
+					Key:   aws.String("connection_logs.s3.enabled"),
+					Value: aws.String("false"),
+				})
+			}
+		}
+
 		if d.HasChange("idle_timeout") || d.IsNewResource() {
 			attributes = append(attributes, &elbv2.LoadBalancerAttribute{
 				Key:   aws.String("idle_timeout.timeout_seconds"),
@@ -837,6 +901,13 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 		"prefix":  "",
 	}
 
+	var connectionLogs []interface{}
+	connectionLogMap := map[string]interface{}{
+		"bucket":  "",
+		"enabled": false,
+		"prefix":  "",
+	}
+
 	for _, attr := range attributesResp.Attributes {
 		switch aws.StringValue(attr.Key) {
 		case "access_logs.s3.enabled":
@@ -845,6 +916,13 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 			accessLogMap["bucket"] = aws.StringValue(attr.Value)
 		case "access_logs.s3.prefix":
 			accessLogMap["prefix"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.enabled":
+			connectionLogMap["enabled"] = aws.StringValue(attr.Value) == "true"
+			connectionLogs = []interface{}{connectionLogMap}
+		case "connection_logs.s3.bucket":
+			connectionLogMap["bucket"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.prefix":
+			connectionLogMap["prefix"] = aws.StringValue(attr.Value)
 		case "idle_timeout.timeout_seconds":
 			timeout, err := strconv.Atoi(aws.StringValue(attr.Value))
 			if err != nil {
@@ -883,6 +961,10 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 		return fmt.Errorf("error setting access_logs: %w", err)
 	}
 
+	if err := d.Set("connection_logs", connectionLogs); err != nil {
+		return fmt.Errorf("error setting connection_logs: %w", err)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.CheckISOErrorTagsUnsupported(err) {
@@ -952,3 +1034,16 @@ func customizeDiffNLBSubnets(_ context.Context, diff *schema.ResourceDiff, v int
 	}
 	return nil
 }
+
+// Connection logs are only supported by Application Load Balancers.
+func customizeDiffConnectionLogs(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if lbType := diff.Get("load_balancer_type").(string); lbType == elbv2.LoadBalancerTypeEnumApplication {
+		return nil
+	}
+
+	if diff.Get("connection_logs.0.enabled").(bool) {
+		return fmt.Errorf("connection_logs is only supported by load balancers of type %q", elbv2.LoadBalancerTypeEnumApplication)
+	}
+
+	return nil
+}
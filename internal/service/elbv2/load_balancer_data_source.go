@@ -109,6 +109,27 @@ func DataSourceLoadBalancer() *schema.Resource {
 				},
 			},
 
+			"connection_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"enable_deletion_protection": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -265,6 +286,13 @@ func dataSourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 		"prefix":  "",
 	}
 
+	var connectionLogs []interface{}
+	connectionLogMap := map[string]interface{}{
+		"bucket":  "",
+		"enabled": false,
+		"prefix":  "",
+	}
+
 	for _, attr := range attributesResp.Attributes {
 		switch aws.StringValue(attr.Key) {
 		case "access_logs.s3.enabled":
@@ -273,6 +301,13 @@ func dataSourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 			accessLogMap["bucket"] = aws.StringValue(attr.Value)
 		case "access_logs.s3.prefix":
 			accessLogMap["prefix"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.enabled":
+			connectionLogMap["enabled"] = aws.StringValue(attr.Value) == "true"
+			connectionLogs = []interface{}{connectionLogMap}
+		case "connection_logs.s3.bucket":
+			connectionLogMap["bucket"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.prefix":
+			connectionLogMap["prefix"] = aws.StringValue(attr.Value)
 		case "idle_timeout.timeout_seconds":
 			timeout, err := strconv.Atoi(aws.StringValue(attr.Value))
 			if err != nil {
@@ -304,6 +339,10 @@ func dataSourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting access_logs: %w", err)
 	}
 
+	if err := d.Set("connection_logs", connectionLogs); err != nil {
+		return fmt.Errorf("error setting connection_logs: %w", err)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.CheckISOErrorTagsUnsupported(err) {
@@ -800,12 +800,19 @@ func TestAccELBV2LoadBalancer_updatedIPAddressType(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccLoadBalancerWithIPAddressTypeUpdatedConfig(lbName),
+				Config: testAccLoadBalancerWithIPAddressTypeUpdatedConfig(lbName, "dualstack"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckLoadBalancerExists(resourceName, &post),
 					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "dualstack"),
 				),
 			},
+			{
+				Config: testAccLoadBalancerWithIPAddressTypeUpdatedConfig(lbName, "dualstack-without-public-ipv4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(resourceName, &post),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "dualstack-without-public-ipv4"),
+				),
+			},
 		},
 	})
 }
@@ -1011,6 +1018,86 @@ func TestAccELBV2LoadBalancer_ALBAccessLogs_prefix(t *testing.T) {
 	})
 }
 
+func TestAccELBV2LoadBalancer_ALB_connectionLogs(t *testing.T) {
+	var conf elbv2.LoadBalancer
+	bucketName := fmt.Sprintf("tf-test-connection-logs-%s", sdkacctest.RandString(6))
+	lbName := fmt.Sprintf("testAccAWSlbconnlog-%s", sdkacctest.RandString(4))
+	resourceName := "aws_lb.test"
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerALBConnectionLogsConfig(true, lbName, bucketName, "connection"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(resourceName, &conf),
+					testAccCheckLoadBalancerAttribute(resourceName, "access_logs.s3.bucket", bucketName),
+					testAccCheckLoadBalancerAttribute(resourceName, "access_logs.s3.enabled", "true"),
+					testAccCheckLoadBalancerAttribute(resourceName, "access_logs.s3.prefix", "access"),
+					testAccCheckLoadBalancerAttribute(resourceName, "connection_logs.s3.bucket", bucketName),
+					testAccCheckLoadBalancerAttribute(resourceName, "connection_logs.s3.enabled", "true"),
+					testAccCheckLoadBalancerAttribute(resourceName, "connection_logs.s3.prefix", "connection"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.prefix", "access"),
+					resource.TestCheckResourceAttr(resourceName, "connection_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_logs.0.bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "connection_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_logs.0.prefix", "connection"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoadBalancerALBConnectionLogsConfig(false, lbName, bucketName, "connection"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(resourceName, &conf),
+					testAccCheckLoadBalancerAttribute(resourceName, "access_logs.s3.enabled", "true"),
+					testAccCheckLoadBalancerAttribute(resourceName, "connection_logs.s3.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_logs.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccELBV2LoadBalancer_NLB_connectionLogs(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-connection-logs-%s", sdkacctest.RandString(6))
+	lbName := fmt.Sprintf("testAccAWSlbconnlog-%s", sdkacctest.RandString(4))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLoadBalancerNLBConnectionLogsConfig(lbName, bucketName),
+				ExpectError: regexp.MustCompile(`connection_logs is only supported by load balancers of type "application"`),
+			},
+		},
+	})
+}
+
 func TestAccELBV2LoadBalancer_NLB_accessLogs(t *testing.T) {
 	var conf elbv2.LoadBalancer
 	bucketName := fmt.Sprintf("tf-test-access-logs-%s", sdkacctest.RandString(6))
@@ -1383,14 +1470,14 @@ func testAccPreCheckElbv2GatewayLoadBalancer(t *testing.T) {
 	t.Skip("skipping acceptance testing: region does not support ELBv2 Gateway Load Balancers")
 }
 
-func testAccLoadBalancerWithIPAddressTypeUpdatedConfig(lbName string) string {
+func testAccLoadBalancerWithIPAddressTypeUpdatedConfig(lbName, ipAddressType string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_lb" "lb_test" {
-  name            = "%s"
+  name            = %[1]q
   security_groups = [aws_security_group.alb_test.id]
   subnets         = [aws_subnet.alb_test_1.id, aws_subnet.alb_test_2.id]
 
-  ip_address_type = "dualstack"
+  ip_address_type = %[2]q
 
   idle_timeout               = 30
   enable_deletion_protection = false
@@ -1412,7 +1499,7 @@ resource "aws_lb_listener" "test" {
 }
 
 resource "aws_lb_target_group" "test" {
-  name     = "%s"
+  name     = %[1]q
   port     = 80
   protocol = "HTTP"
   vpc_id   = aws_vpc.alb_test.id
@@ -1493,7 +1580,7 @@ resource "aws_security_group" "alb_test" {
     Name = "TestAccAWSALB_basic"
   }
 }
-`, lbName, lbName))
+`, lbName, ipAddressType))
 }
 
 func testAccLoadBalancerWithIPAddressTypeConfig(lbName string) string {
@@ -2859,6 +2946,28 @@ resource "aws_lb" "test" {
 `, lbName))
 }
 
+func testAccLoadBalancerALBConnectionLogsConfig(enabled bool, lbName, bucketName, bucketPrefix string) string {
+	return acctest.ConfigCompose(testAccLoadBalancerALBAccessLogsBaseConfig(bucketName), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  internal = true
+  name     = %[1]q
+  subnets  = aws_subnet.alb_test.*.id
+
+  access_logs {
+    bucket  = aws_s3_bucket_policy.test.bucket
+    enabled = true
+    prefix  = "access"
+  }
+
+  connection_logs {
+    bucket  = aws_s3_bucket_policy.test.bucket
+    enabled = %[2]t
+    prefix  = %[3]q
+  }
+}
+`, lbName, enabled, bucketPrefix))
+}
+
 func testAccLoadBalancerNLBAccessLogsBaseConfig(bucketName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 data "aws_elb_service_account" "current" {}
@@ -2947,6 +3056,22 @@ resource "aws_lb" "test" {
 `, lbName))
 }
 
+func testAccLoadBalancerNLBConnectionLogsConfig(lbName, bucketName string) string {
+	return acctest.ConfigCompose(testAccLoadBalancerNLBAccessLogsBaseConfig(bucketName), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  internal           = true
+  load_balancer_type = "network"
+  name               = %[1]q
+  subnets            = aws_subnet.alb_test.*.id
+
+  connection_logs {
+    bucket  = aws_s3_bucket_policy.test.bucket
+    enabled = true
+  }
+}
+`, lbName))
+}
+
 func testAccLoadBalancerConfig_nosg(lbName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_lb" "lb_test" {
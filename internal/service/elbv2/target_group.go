@@ -124,6 +124,13 @@ func ResourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"ip_address_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(elbv2.TargetGroupIpAddressTypeEnum_Values(), false),
+			},
 			"lambda_multi_value_headers_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -266,6 +273,26 @@ func ResourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"target_health_state": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_unhealthy_connection_termination": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"unhealthy_draining_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 360000),
+						},
+					},
+				},
+			},
 			"target_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -328,6 +355,10 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 			params.ProtocolVersion = aws.String(d.Get("protocol_version").(string))
 		}
 		params.VpcId = aws.String(d.Get("vpc_id").(string))
+
+		if v, ok := d.GetOk("ip_address_type"); ok {
+			params.IpAddressType = aws.String(v.(string))
+		}
 	}
 
 	if healthChecks := d.Get("health_check").([]interface{}); len(healthChecks) == 1 {
@@ -508,6 +539,10 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 		}
+
+		if v, ok := d.GetOk("target_health_state"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			attrs = append(attrs, expandTargetGroupTargetHealthStateAttributes(v.([]interface{})[0].(map[string]interface{}))...)
+		}
 	case elbv2.TargetTypeEnumLambda:
 		if v, ok := d.GetOk("lambda_multi_value_headers_enabled"); ok {
 			attrs = append(attrs, &elbv2.TargetGroupAttribute{
@@ -746,6 +781,12 @@ func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
+		if d.HasChange("target_health_state") {
+			if v := d.Get("target_health_state").([]interface{}); len(v) > 0 && v[0] != nil {
+				attrs = append(attrs, expandTargetGroupTargetHealthStateAttributes(v[0].(map[string]interface{}))...)
+			}
+		}
+
 		if d.HasChange("load_balancing_algorithm_type") {
 			attrs = append(attrs, &elbv2.TargetGroupAttribute{
 				Key:   aws.String("load_balancing.algorithm.type"),
@@ -920,6 +961,7 @@ func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, target
 		d.Set("vpc_id", targetGroup.VpcId)
 		d.Set("port", targetGroup.Port)
 		d.Set("protocol", targetGroup.Protocol)
+		d.Set("ip_address_type", targetGroup.IpAddressType)
 	}
 
 	switch d.Get("protocol").(string) {
@@ -983,6 +1025,15 @@ func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, target
 		return fmt.Errorf("error setting stickiness: %w", err)
 	}
 
+	targetHealthStateAttr, err := flattenTargetGroupTargetHealthState(attrResp.Attributes)
+	if err != nil {
+		return fmt.Errorf("error flattening target_health_state: %w", err)
+	}
+
+	if err := d.Set("target_health_state", targetHealthStateAttr); err != nil {
+		return fmt.Errorf("error setting target_health_state: %w", err)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.CheckISOErrorTagsUnsupported(err) {
@@ -1049,6 +1100,58 @@ func flattenTargetGroupStickiness(attributes []*elbv2.TargetGroupAttribute) ([]i
 	return []interface{}{m}, nil
 }
 
+func expandTargetGroupTargetHealthStateAttributes(tfMap map[string]interface{}) []*elbv2.TargetGroupAttribute {
+	if tfMap == nil {
+		return nil
+	}
+
+	return []*elbv2.TargetGroupAttribute{
+		{
+			Key:   aws.String("target_health_state.unhealthy.connection_termination.enabled"),
+			Value: aws.String(strconv.FormatBool(tfMap["enable_unhealthy_connection_termination"].(bool))),
+		},
+		{
+			Key:   aws.String("target_health_state.unhealthy.draining_interval_seconds"),
+			Value: aws.String(fmt.Sprintf("%d", tfMap["unhealthy_draining_interval"].(int))),
+		},
+	}
+}
+
+// The target_health_state.* attributes are only returned for target groups
+// that support them, so an empty list is returned for all others.
+func flattenTargetGroupTargetHealthState(attributes []*elbv2.TargetGroupAttribute) ([]interface{}, error) {
+	var found bool
+	m := map[string]interface{}{
+		"enable_unhealthy_connection_termination": true,
+		"unhealthy_draining_interval":             0,
+	}
+
+	for _, attr := range attributes {
+		switch aws.StringValue(attr.Key) {
+		case "target_health_state.unhealthy.connection_termination.enabled":
+			enabled, err := strconv.ParseBool(aws.StringValue(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("error converting target_health_state.unhealthy.connection_termination.enabled to bool: %s", aws.StringValue(attr.Value))
+			}
+			m["enable_unhealthy_connection_termination"] = enabled
+			found = true
+		case "target_health_state.unhealthy.draining_interval_seconds":
+			interval, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("error converting target_health_state.unhealthy.draining_interval_seconds to int: %s", aws.StringValue(attr.Value))
+			}
+			m["unhealthy_draining_interval"] = interval
+			found = true
+		}
+	}
+
+	if !found {
+		return []interface{}{}, nil
+	}
+
+	return []interface{}{m}, nil
+}
+
 func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	protocol := diff.Get("protocol").(string)
 
@@ -1089,6 +1192,18 @@ func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDi
 		}
 	}
 
+	// Unhealthy target connection termination is only supported for TCP and TLS target groups.
+	// Check the configuration rather than the planned value, which includes values read back from AWS.
+	if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() {
+		if v := rawConfig.GetAttr("target_health_state"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			switch protocol {
+			case elbv2.ProtocolEnumTcp, elbv2.ProtocolEnumTls:
+			default:
+				return fmt.Errorf("target_health_state is only supported for target groups with TCP or TLS protocol, got %q", protocol)
+			}
+		}
+	}
+
 	if diff.Id() == "" {
 		return nil
 	}
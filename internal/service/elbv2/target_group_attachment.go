@@ -3,6 +3,7 @@ package elbv2
 import (
 	"fmt"
 	"log"
+	"net"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -166,7 +167,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 			continue
 		}
 
-		if aws.StringValue(targetDesc.Target.Id) == d.Get("target_id").(string) {
+		if targetIDsEqual(aws.StringValue(targetDesc.Target.Id), d.Get("target_id").(string)) {
 			// These will catch targets being removed by hand (draining as we plan) or that have been removed for a while
 			// without trying to re-create ones that are just not in use. For example, a target can be `unused` if the
 			// target group isnt assigned to anything, a scenario where we don't want to continuously recreate the resource.
@@ -192,3 +193,16 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// targetIDsEqual reports whether two target IDs refer to the same target.
+// IP address targets are compared by value so that an IPv6 address in a
+// non-canonical form matches the form returned by the API.
+func targetIDsEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+
+	return ipA != nil && ipB != nil && ipA.Equal(ipB)
+}
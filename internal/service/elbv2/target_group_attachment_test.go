@@ -112,6 +112,26 @@ func TestAccELBV2TargetGroupAttachment_ipAddress(t *testing.T) {
 	})
 }
 
+func TestAccELBV2TargetGroupAttachment_ipv6Address(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetGroupAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupAttachmentTargetIdIPv6AddressConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupAttachmentExists("aws_lb_target_group_attachment.test"),
+					resource.TestCheckResourceAttrPair("aws_lb_target_group_attachment.test", "target_id", "aws_instance.test", "ipv6_addresses.0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroupAttachment_lambda(t *testing.T) {
 	targetGroupName := fmt.Sprintf("test-target-group-%s", sdkacctest.RandString(10))
 
@@ -368,6 +388,56 @@ resource "aws_lb_target_group_attachment" "test" {
 `, rName)
 }
 
+func testAccTargetGroupAttachmentTargetIdIPv6AddressConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHvmEbsAmi(), acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.0.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 1)
+  ipv6_cidr_block   = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_instance" "test" {
+  ami                = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type      = "t3.micro"
+  ipv6_address_count = 1
+  subnet_id          = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name            = %[1]q
+  port            = 443
+  protocol        = "HTTPS"
+  target_type     = "ip"
+  ip_address_type = "ipv6"
+  vpc_id          = aws_vpc.test.id
+}
+
+resource "aws_lb_target_group_attachment" "test" {
+  availability_zone = aws_instance.test.availability_zone
+  target_group_arn  = aws_lb_target_group.test.arn
+  target_id         = aws_instance.test.ipv6_addresses[0]
+}
+`, rName))
+}
+
 func testAccTargetGroupAttachmentTargetIdLambdaConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
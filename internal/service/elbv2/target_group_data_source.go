@@ -78,6 +78,10 @@ func DataSourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"ip_address_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"lambda_multi_value_headers_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -139,6 +143,22 @@ func DataSourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"target_health_state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_unhealthy_connection_termination": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"unhealthy_draining_interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"target_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -200,6 +220,7 @@ func dataSourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("vpc_id", targetGroup.VpcId)
 		d.Set("port", targetGroup.Port)
 		d.Set("protocol", targetGroup.Protocol)
+		d.Set("ip_address_type", targetGroup.IpAddressType)
 	}
 	switch d.Get("protocol").(string) {
 	case elbv2.ProtocolEnumHttp, elbv2.ProtocolEnumHttps:
@@ -266,6 +287,15 @@ func dataSourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting stickiness: %w", err)
 	}
 
+	targetHealthStateAttr, err := flattenTargetGroupTargetHealthState(attrResp.Attributes)
+	if err != nil {
+		return fmt.Errorf("error flattening target_health_state: %w", err)
+	}
+
+	if err := d.Set("target_health_state", targetHealthStateAttr); err != nil {
+		return fmt.Errorf("error setting target_health_state: %w", err)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.CheckISOErrorTagsUnsupported(err) {
@@ -389,6 +389,7 @@ func TestAccELBV2TargetGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTPS"),
 					resource.TestCheckResourceAttr(resourceName, "protocol_version", "HTTP1"),
 					resource.TestCheckResourceAttrSet(resourceName, "vpc_id"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "ipv4"),
 					resource.TestCheckResourceAttr(resourceName, "deregistration_delay", "200"),
 					resource.TestCheckResourceAttr(resourceName, "slow_start", "0"),
 					resource.TestCheckResourceAttr(resourceName, "stickiness.#", "1"),
@@ -444,6 +445,29 @@ func TestAccELBV2TargetGroup_basicUdp(t *testing.T) {
 	})
 }
 
+func TestAccELBV2TargetGroup_ipAddressTypeIPv6(t *testing.T) {
+	var conf elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupConfig_ipAddressTypeIPv6(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "ipv6"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "ip"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_changeNameForceNew(t *testing.T) {
 	var before, after elbv2.TargetGroup
 	rNameBefore := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -849,6 +873,62 @@ func TestAccELBV2TargetGroup_networkLB_TargetGroupWithConnectionTermination(t *t
 	})
 }
 
+func TestAccELBV2TargetGroup_NetworkLB_targetHealthState(t *testing.T) {
+	var conf elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupConfig_typeTCP(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.enable_unhealthy_connection_termination", "true"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.unhealthy_draining_interval", "0"),
+				),
+			},
+			{
+				Config: testAccTargetGroupConfig_typeTCP_targetHealthState(rName, false, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.enable_unhealthy_connection_termination", "false"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.unhealthy_draining_interval", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetGroupConfig_typeTCP_targetHealthState(rName, true, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.enable_unhealthy_connection_termination", "true"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.unhealthy_draining_interval", "0"),
+				),
+			},
+			{
+				// target_health_state read back from the TCP target group must not be validated against the new protocol.
+				Config: testAccTargetGroupConfig_updatedProtocol(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "protocol", elbv2.ProtocolEnumHttp),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_NetworkLB_targetGroupWithProxy(t *testing.T) {
 	var confBefore, confAfter elbv2.TargetGroup
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2499,6 +2579,32 @@ resource "aws_vpc" "test" {
 `, rName)
 }
 
+func testAccTargetGroupConfig_ipAddressTypeIPv6(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name            = %[1]q
+  port            = 443
+  protocol        = "HTTPS"
+  target_type     = "ip"
+  ip_address_type = "ipv6"
+  vpc_id          = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.0.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
 func testAccTargetGroupConfig_enableHealthcheck(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lb_target_group" "test" {
@@ -2866,6 +2972,34 @@ resource "aws_vpc" "test" {
 `, rName)
 }
 
+func testAccTargetGroupConfig_typeTCP_targetHealthState(rName string, enabled bool, interval int) string {
+	return fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 8082
+  protocol = "TCP"
+  vpc_id   = aws_vpc.test.id
+
+  target_health_state {
+    enable_unhealthy_connection_termination = %[2]t
+    unhealthy_draining_interval             = %[3]d
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, enabled, interval)
+}
+
 func testAccTargetGroupConfig_typeTCP_withConnectionTermination(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lb_target_group" "test" {
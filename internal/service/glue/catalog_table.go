@@ -48,6 +48,26 @@ func ResourceCatalogTable() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 2048),
 			},
+			"federated_table": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -57,6 +77,44 @@ func ResourceCatalogTable() *schema.Resource {
 					validation.StringDoesNotMatch(regexp.MustCompile(`[A-Z]`), "uppercase characters cannot be used"),
 				),
 			},
+			// open_table_format_input is only used by CreateTable and is not returned by GetTable.
+			"open_table_format_input": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				ForceNew:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressOpenTableFormatInputWithoutState,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iceberg_input": {
+							Type:             schema.TypeList,
+							Required:         true,
+							ForceNew:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressOpenTableFormatInputWithoutState,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metadata_operation": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										DiffSuppressFunc: suppressOpenTableFormatInputWithoutState,
+										ValidateFunc:     validation.StringInSlice(glue.MetadataOperation_Values(), false),
+									},
+									"version": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										Default:          "2",
+										DiffSuppressFunc: suppressOpenTableFormatInputWithoutState,
+										ValidateFunc:     validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"owner": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -369,6 +427,10 @@ func resourceCatalogTableCreate(d *schema.ResourceData, meta interface{}) error
 		PartitionIndexes: expandGlueTablePartitionIndexes(d.Get("partition_index").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("open_table_format_input"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OpenTableFormatInput = expandGlueTableOpenTableFormatInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Glue catalog table input: %#v", input)
 	_, err := conn.CreateTable(input)
 	if err != nil {
@@ -429,10 +491,32 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("view_expanded_text", table.ViewExpandedText)
 	d.Set("table_type", table.TableType)
 
-	if err := d.Set("parameters", aws.StringValueMap(table.Parameters)); err != nil {
+	parameters := aws.StringValueMap(table.Parameters)
+
+	// Don't add the parameters that AWS Glue manages for Iceberg tables to state
+	// unless they are configured, otherwise every plan shows a diff removing them.
+	if isGlueTableIceberg(table.Parameters) {
+		configured := d.Get("parameters").(map[string]interface{})
+
+		for _, k := range glueTableIcebergParameters {
+			if _, ok := configured[k]; !ok {
+				delete(parameters, k)
+			}
+		}
+	}
+
+	if err := d.Set("parameters", parameters); err != nil {
 		return fmt.Errorf("error setting parameters: %w", err)
 	}
 
+	if table.FederatedTable != nil {
+		if err := d.Set("federated_table", []interface{}{flattenGlueTableFederatedTable(table.FederatedTable)}); err != nil {
+			return fmt.Errorf("error setting federated_table: %w", err)
+		}
+	} else {
+		d.Set("federated_table", nil)
+	}
+
 	if table.TargetTable != nil {
 		if err := d.Set("target_table", []interface{}{flattenGlueTableTargetTable(table.TargetTable)}); err != nil {
 			return fmt.Errorf("error setting target_table: %w", err)
@@ -463,7 +547,7 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 func resourceCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlueConn
 
-	catalogID, dbName, _, err := ReadTableID(d.Id())
+	catalogID, dbName, name, err := ReadTableID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -474,6 +558,27 @@ func resourceCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error
 		TableInput:   expandGlueTableInput(d),
 	}
 
+	// UpdateTable replaces the table's parameters, so keep the parameters that
+	// AWS Glue manages for Iceberg tables, such as the metadata location.
+	out, err := FindTableByName(conn, catalogID, dbName, name)
+	if err != nil {
+		return fmt.Errorf("Error reading Glue Catalog Table: %w", err)
+	}
+
+	if table := out.Table; isGlueTableIceberg(table.Parameters) {
+		if updateTableInput.TableInput.Parameters == nil {
+			updateTableInput.TableInput.Parameters = map[string]*string{}
+		}
+
+		for _, k := range glueTableIcebergParameters {
+			if v, ok := table.Parameters[k]; ok {
+				if _, ok := updateTableInput.TableInput.Parameters[k]; !ok {
+					updateTableInput.TableInput.Parameters[k] = v
+				}
+			}
+		}
+	}
+
 	if _, err := conn.UpdateTable(updateTableInput); err != nil {
 		return fmt.Errorf("Error updating Glue Catalog Table: %w", err)
 	}
@@ -554,6 +659,17 @@ func expandGlueTableInput(d *schema.ResourceData) *glue.TableInput {
 	return tableInput
 }
 
+// glueTableIcebergParameters are the table parameters managed by AWS Glue for Apache Iceberg tables.
+var glueTableIcebergParameters = []string{
+	"metadata_location",
+	"previous_metadata_location",
+	"table_type",
+}
+
+func isGlueTableIceberg(parameters map[string]*string) bool {
+	return strings.EqualFold(aws.StringValue(parameters["table_type"]), "ICEBERG")
+}
+
 func expandGlueTablePartitionIndexes(a []interface{}) []*glue.PartitionIndex {
 	partitionIndexes := make([]*glue.PartitionIndex, 0, len(a))
 
@@ -1031,3 +1147,69 @@ func flattenGlueTableTargetTable(apiObject *glue.TableIdentifier) map[string]int
 
 	return tfMap
 }
+
+func expandGlueTableOpenTableFormatInput(tfMap map[string]interface{}) *glue.OpenTableFormatInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.OpenTableFormatInput_{}
+
+	if v, ok := tfMap["iceberg_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IcebergInput = expandGlueTableIcebergInput(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandGlueTableIcebergInput(tfMap map[string]interface{}) *glue.IcebergInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.IcebergInput_{}
+
+	if v, ok := tfMap["metadata_operation"].(string); ok && v != "" {
+		apiObject.MetadataOperation = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenGlueTableFederatedTable(apiObject *glue.FederatedTable) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConnectionName; v != nil {
+		tfMap["connection_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DatabaseIdentifier; v != nil {
+		tfMap["database_identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Identifier; v != nil {
+		tfMap["identifier"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+// suppressOpenTableFormatInputWithoutState suppresses differences in open_table_format_input
+// for an existing table that has none in state, e.g. an imported table, as it cannot be read back.
+func suppressOpenTableFormatInputWithoutState(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	o, _ := d.GetChange("open_table_format_input")
+
+	return len(o.([]interface{})) == 0
+}
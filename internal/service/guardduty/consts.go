@@ -0,0 +1,21 @@
+package guardduty
+
+import (
+	"github.com/aws/aws-sdk-go/service/guardduty"
+)
+
+// Feature names not currently provided by the AWS Go SDK.
+const (
+	detectorFeatureRuntimeMonitoring = "RUNTIME_MONITORING"
+
+	featureAdditionalConfigurationECSFargateAgentManagement = "ECS_FARGATE_AGENT_MANAGEMENT"
+	featureAdditionalConfigurationEC2AgentManagement        = "EC2_AGENT_MANAGEMENT"
+)
+
+func detectorFeature_Values() []string {
+	return append(guardduty.DetectorFeature_Values(), detectorFeatureRuntimeMonitoring)
+}
+
+func featureAdditionalConfiguration_Values() []string {
+	return append(guardduty.FeatureAdditionalConfiguration_Values(), featureAdditionalConfigurationECSFargateAgentManagement, featureAdditionalConfigurationEC2AgentManagement)
+}
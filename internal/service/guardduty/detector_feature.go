@@ -0,0 +1,199 @@
+package guardduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceDetectorFeature() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDetectorFeaturePut,
+		Read:   resourceDetectorFeatureRead,
+		Update: resourceDetectorFeaturePut,
+		Delete: resourceDetectorFeatureDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(featureAdditionalConfiguration_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(detectorFeature_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDetectorFeaturePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name := d.Get("detector_id").(string), d.Get("name").(string)
+	feature := &guardduty.DetectorFeatureConfiguration{
+		Name:   aws.String(name),
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		feature.AdditionalConfiguration = expandDetectorAdditionalConfigurations(v.([]interface{}))
+	}
+
+	// Features omitted from the request are left unchanged.
+	input := &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Features:   []*guardduty.DetectorFeatureConfiguration{feature},
+	}
+
+	log.Printf("[DEBUG] Updating GuardDuty Detector Feature: %s", input)
+	_, err := conn.UpdateDetector(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating GuardDuty Detector (%s) Feature (%s): %w", detectorID, name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(detectorFeatureCreateResourceID(detectorID, name))
+	}
+
+	return resourceDetectorFeatureRead(d, meta)
+}
+
+func resourceDetectorFeatureRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name, err := DetectorFeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	feature, err := FindDetectorFeatureByTwoPartKey(conn, detectorID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Detector Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading GuardDuty Detector Feature (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+		return fmt.Errorf("error setting additional_configuration: %w", err)
+	}
+
+	d.Set("detector_id", detectorID)
+	d.Set("name", feature.Name)
+	d.Set("status", feature.Status)
+
+	return nil
+}
+
+func resourceDetectorFeatureDelete(d *schema.ResourceData, meta interface{}) error {
+	// Features cannot be removed from a detector, only disabled.
+	log.Printf("[WARN] GuardDuty Detector Feature (%s) cannot be deleted, removing from state only", d.Id())
+
+	return nil
+}
+
+const detectorFeatureResourceIDSeparator = ":"
+
+func detectorFeatureCreateResourceID(detectorID, name string) string {
+	parts := []string{detectorID, name}
+	id := strings.Join(parts, detectorFeatureResourceIDSeparator)
+
+	return id
+}
+
+func DetectorFeatureParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, detectorFeatureResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTOR_ID%[2]sFEATURE_NAME", id, detectorFeatureResourceIDSeparator)
+}
+
+func expandDetectorAdditionalConfigurations(tfList []interface{}) []*guardduty.DetectorAdditionalConfiguration {
+	var apiObjects []*guardduty.DetectorAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.DetectorAdditionalConfiguration{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorAdditionalConfigurationResults(apiObjects []*guardduty.DetectorAdditionalConfigurationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"status": aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,191 @@
+package guardduty_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+)
+
+func testAccDetectorFeature_basic(t *testing.T) {
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_basic("RDS_LOGIN_EVENTS", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RDS_LOGIN_EVENTS"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorFeatureConfig_basic("RDS_LOGIN_EVENTS", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeature_additionalConfiguration(t *testing.T) {
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_additionalConfiguration("ENABLED", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", "EKS_ADDON_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "name", "EKS_RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccDetectorFeatureConfig_additionalConfiguration("ENABLED", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeature_multiple(t *testing.T) {
+	resource1Name := "aws_guardduty_detector_feature.test1"
+	resource2Name := "aws_guardduty_detector_feature.test2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_multiple("ENABLED", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resource1Name),
+					testAccCheckDetectorFeatureExists(resource2Name),
+					resource.TestCheckResourceAttr(resource1Name, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resource2Name, "status", "DISABLED"),
+				),
+			},
+			{
+				// Changing one feature must not affect the other.
+				Config: testAccDetectorFeatureConfig_multiple("ENABLED", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resource1Name),
+					testAccCheckDetectorFeatureExists(resource2Name),
+					resource.TestCheckResourceAttr(resource1Name, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resource2Name, "status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorFeatureExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GuardDuty Detector Feature ID is set")
+		}
+
+		detectorID, name, err := tfguardduty.DetectorFeatureParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+
+		_, err = tfguardduty.FindDetectorFeatureByTwoPartKey(conn, detectorID, name)
+
+		return err
+	}
+}
+
+func testAccDetectorFeatureConfig_basic(name, status string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = %[1]q
+  status      = %[2]q
+}
+`, name, status)
+}
+
+func testAccDetectorFeatureConfig_additionalConfiguration(status, additionalConfigurationStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EKS_RUNTIME_MONITORING"
+  status      = %[1]q
+
+  additional_configuration {
+    name   = "EKS_ADDON_MANAGEMENT"
+    status = %[2]q
+  }
+}
+`, status, additionalConfigurationStatus)
+}
+
+func testAccDetectorFeatureConfig_multiple(status1, status2 string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test1" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "S3_DATA_EVENTS"
+  status      = %[1]q
+}
+
+resource "aws_guardduty_detector_feature" "test2" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "LAMBDA_NETWORK_LOGS"
+  status      = %[2]q
+}
+`, status1, status2)
+}
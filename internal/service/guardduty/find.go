@@ -0,0 +1,50 @@
+package guardduty
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDetectorByID(conn *guardduty.GuardDuty, id string) (*guardduty.GetDetectorOutput, error) {
+	input := &guardduty.GetDetectorInput{
+		DetectorId: aws.String(id),
+	}
+
+	output, err := conn.GetDetector(input)
+
+	if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDetectorFeatureByTwoPartKey(conn *guardduty.GuardDuty, detectorID, name string) (*guardduty.DetectorFeatureConfigurationResult, error) {
+	output, err := FindDetectorByID(conn, detectorID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.Features {
+		if v != nil && aws.StringValue(v.Name) == name {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
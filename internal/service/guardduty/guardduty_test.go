@@ -14,6 +14,11 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"datasource_basic":   testAccDetectorDataSource_basic,
 			"datasource_id":      testAccDetectorDataSource_ID,
 		},
+		"DetectorFeature": {
+			"basic":                   testAccDetectorFeature_basic,
+			"additionalConfiguration": testAccDetectorFeature_additionalConfiguration,
+			"multiple":                testAccDetectorFeature_multiple,
+		},
 		"Filter": {
 			"basic":      testAccFilter_basic,
 			"update":     testAccFilter_update,
@@ -234,16 +234,10 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), policyDocument)
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policyDocument)
 
 	if err != nil {
-		return fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err)
-	}
-
-	policyToSet, err = structure.NormalizeJsonString(policyToSet)
-
-	if err != nil {
-		return fmt.Errorf("policy (%s) is invalid JSON: %w", policyToSet, err)
+		return fmt.Errorf("error setting IAM policy (%s) document: %w", d.Id(), err)
 	}
 
 	d.Set("policy", policyToSet)
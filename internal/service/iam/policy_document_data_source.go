@@ -32,6 +32,10 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"merge_by_sid": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"override_json": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -116,6 +120,16 @@ func DataSourcePolicyDocument() *schema.Resource {
 
 func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
 	mergedDoc := &IAMPolicyDoc{}
+	mergeBySid := d.Get("merge_by_sid").(bool)
+
+	merge := func(newDoc *IAMPolicyDoc) error {
+		if mergeBySid {
+			return mergedDoc.MergeBySid(newDoc)
+		}
+
+		mergedDoc.Merge(newDoc)
+		return nil
+	}
 
 	if v, ok := d.GetOk("source_json"); ok {
 		if err := json.Unmarshal([]byte(v.(string)), mergedDoc); err != nil {
@@ -141,7 +155,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 
 			// assure all statements in sourceDoc are unique before merging
 			for stmtIndex, stmt := range sourceDoc.Statements {
-				if stmt.Sid != "" {
+				if stmt.Sid != "" && !mergeBySid {
 					if _, sidExists := sidMap[stmt.Sid]; sidExists {
 						return fmt.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
 					}
@@ -149,7 +163,9 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				}
 			}
 
-			mergedDoc.Merge(sourceDoc)
+			if err := merge(sourceDoc); err != nil {
+				return err
+			}
 		}
 
 	}
@@ -242,7 +258,9 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// merge our current document into mergedDoc
-	mergedDoc.Merge(doc)
+	if err := merge(doc); err != nil {
+		return err
+	}
 
 	// merge override_policy_documents policies into mergedDoc in order specified
 	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]interface{})) > 0 {
@@ -252,7 +270,9 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				return err
 			}
 
-			mergedDoc.Merge(overrideDoc)
+			if err := merge(overrideDoc); err != nil {
+				return err
+			}
 		}
 
 	}
@@ -264,7 +284,9 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 			return err
 		}
 
-		mergedDoc.Merge(overrideDoc)
+		if err := merge(overrideDoc); err != nil {
+			return err
+		}
 	}
 
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
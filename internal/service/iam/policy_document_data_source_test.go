@@ -165,6 +165,38 @@ func TestAccIAMPolicyDocumentDataSource_overrideList(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_mergeBySid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentMergeBySidConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_merge_by_sid", "json",
+						testAccPolicyDocumentMergeBySidExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_mergeBySidConflictingEffect(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentMergeBySidConflictingEffectConfig,
+				ExpectError: regexp.MustCompile(`cannot merge statements with Sid \(S3\): effects differ`),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_noStatementMerge(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -969,6 +1001,148 @@ var testAccPolicyDocumentOverrideListExpectedJSON = `{
   ]
 }`
 
+var testAccPolicyDocumentMergeBySidConfig = `
+data "aws_iam_policy_document" "source_a" {
+  statement {
+    sid       = "S3"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::bucket-a/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:aws:iam::111111111111:root"]
+    }
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["true"]
+    }
+  }
+}
+
+data "aws_iam_policy_document" "source_b" {
+  statement {
+    sid       = "S3"
+    actions   = ["s3:GetObject", "s3:PutObject"]
+    resources = ["arn:aws:s3:::bucket-b/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:aws:iam::222222222222:root"]
+    }
+  }
+}
+
+data "aws_iam_policy_document" "override" {
+  statement {
+    sid       = "List"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::bucket-a", "arn:aws:s3:::bucket-b"]
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:PrincipalOrgID"
+      values   = ["o-exampleorgid"]
+    }
+  }
+}
+
+data "aws_iam_policy_document" "test_merge_by_sid" {
+  merge_by_sid = true
+
+  source_policy_documents = [
+    data.aws_iam_policy_document.source_a.json,
+    data.aws_iam_policy_document.source_b.json,
+  ]
+
+  statement {
+    sid       = "List"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::bucket-a"]
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["true"]
+    }
+  }
+
+  override_policy_documents = [
+    data.aws_iam_policy_document.override.json,
+  ]
+}
+`
+
+var testAccPolicyDocumentMergeBySidExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "S3",
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject",
+        "s3:GetObject"
+      ],
+      "Resource": [
+        "arn:aws:s3:::bucket-b/*",
+        "arn:aws:s3:::bucket-a/*"
+      ],
+      "Principal": {
+        "AWS": [
+          "arn:aws:iam::222222222222:root",
+          "arn:aws:iam::111111111111:root"
+        ]
+      },
+      "Condition": {
+        "Bool": {
+          "aws:SecureTransport": [
+            "true"
+          ]
+        }
+      }
+    },
+    {
+      "Sid": "List",
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": [
+        "arn:aws:s3:::bucket-b",
+        "arn:aws:s3:::bucket-a"
+      ],
+      "Condition": {
+        "StringEquals": {
+          "aws:PrincipalOrgID": [
+            "o-exampleorgid"
+          ]
+        }
+      }
+    }
+  ]
+}`
+
+var testAccPolicyDocumentMergeBySidConflictingEffectConfig = `
+data "aws_iam_policy_document" "source" {
+  statement {
+    sid       = "S3"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_merge_by_sid_conflicting_effect" {
+  merge_by_sid            = true
+  source_policy_documents = [data.aws_iam_policy_document.source.json]
+
+  statement {
+    sid       = "S3"
+    effect    = "Deny"
+    actions   = ["s3:DeleteObject"]
+    resources = ["*"]
+  }
+}
+`
+
 var testAccPolicyDocumentNoStatementMergeConfig = `
 data "aws_iam_policy_document" "source" {
   statement {
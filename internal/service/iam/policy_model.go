@@ -70,6 +70,103 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// MergeBySid merges newDoc into s like Merge, except that a statement sharing its Sid
+// with an existing statement is combined with it instead of replacing it.
+func (s *IAMPolicyDoc) MergeBySid(newDoc *IAMPolicyDoc) error {
+	if len(newDoc.Id) > 0 {
+		s.Id = newDoc.Id
+	}
+
+	if newDoc.Version > s.Version {
+		s.Version = newDoc.Version
+	}
+
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			s.Statements = append(s.Statements, newStatement)
+			continue
+		}
+
+		var seen bool
+		for _, existingStatement := range s.Statements {
+			if existingStatement.Sid == newStatement.Sid {
+				if err := existingStatement.Merge(newStatement); err != nil {
+					return err
+				}
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			s.Statements = append(s.Statements, newStatement)
+		}
+	}
+
+	return nil
+}
+
+// Merge combines newStatement into s. Actions, resources and principals are unioned.
+// Statements with different conditions, or whose union would mix an element with its
+// Not counterpart (e.g. Action and NotAction), cannot be merged.
+func (s *IAMPolicyStatement) Merge(newStatement *IAMPolicyStatement) error {
+	if s.Effect != newStatement.Effect {
+		return fmt.Errorf("cannot merge statements with Sid (%s): effects differ (%s, %s)", s.Sid, s.Effect, newStatement.Effect)
+	}
+
+	actions := iamPolicyUnionStringLists(s.Actions, newStatement.Actions)
+	notActions := iamPolicyUnionStringLists(s.NotActions, newStatement.NotActions)
+	resources := iamPolicyUnionStringLists(s.Resources, newStatement.Resources)
+	notResources := iamPolicyUnionStringLists(s.NotResources, newStatement.NotResources)
+	principals := iamPolicyUnionPrincipals(s.Principals, newStatement.Principals)
+	notPrincipals := iamPolicyUnionPrincipals(s.NotPrincipals, newStatement.NotPrincipals)
+
+	if actions != nil && notActions != nil {
+		return fmt.Errorf("cannot merge statements with Sid (%s): result would contain both Action and NotAction", s.Sid)
+	}
+
+	if resources != nil && notResources != nil {
+		return fmt.Errorf("cannot merge statements with Sid (%s): result would contain both Resource and NotResource", s.Sid)
+	}
+
+	if len(principals) > 0 && len(notPrincipals) > 0 {
+		return fmt.Errorf("cannot merge statements with Sid (%s): result would contain both Principal and NotPrincipal", s.Sid)
+	}
+
+	conditions := s.Conditions
+
+	if len(newStatement.Conditions) > 0 {
+		if len(conditions) > 0 {
+			existing, err := json.Marshal(conditions)
+
+			if err != nil {
+				return fmt.Errorf("cannot merge statements with Sid (%s): %w", s.Sid, err)
+			}
+
+			new, err := json.Marshal(newStatement.Conditions)
+
+			if err != nil {
+				return fmt.Errorf("cannot merge statements with Sid (%s): %w", s.Sid, err)
+			}
+
+			if string(existing) != string(new) {
+				return fmt.Errorf("cannot merge statements with Sid (%s): conditions differ", s.Sid)
+			}
+		}
+
+		conditions = newStatement.Conditions
+	}
+
+	s.Actions = actions
+	s.NotActions = notActions
+	s.Resources = resources
+	s.NotResources = notResources
+	s.Principals = principals
+	s.NotPrincipals = notPrincipals
+	s.Conditions = conditions
+
+	return nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -220,3 +317,61 @@ func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
 	return ret
 }
+
+// iamPolicyStringList returns the strings in a policy element, which is either
+// a single string or a list of strings.
+func iamPolicyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		ret := make([]string, 0, len(v))
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				ret = append(ret, s)
+			}
+		}
+		return ret
+	}
+
+	return nil
+}
+
+func iamPolicyUnionStringLists(a, b interface{}) interface{} {
+	var ret []interface{}
+	seen := make(map[string]struct{})
+
+	for _, s := range append(iamPolicyStringList(a), iamPolicyStringList(b)...) {
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		ret = append(ret, s)
+	}
+
+	if len(ret) == 0 {
+		return nil
+	}
+
+	return iamPolicyDecodeConfigStringList(ret)
+}
+
+func iamPolicyUnionPrincipals(a, b IAMPolicyStatementPrincipalSet) IAMPolicyStatementPrincipalSet {
+	var ret IAMPolicyStatementPrincipalSet
+	identifiers := make(map[string]interface{})
+
+	for _, p := range append(append(IAMPolicyStatementPrincipalSet{}, a...), b...) {
+		if _, ok := identifiers[p.Type]; !ok {
+			ret = append(ret, IAMPolicyStatementPrincipal{Type: p.Type})
+		}
+		identifiers[p.Type] = iamPolicyUnionStringLists(identifiers[p.Type], p.Identifiers)
+	}
+
+	for i, p := range ret {
+		ret[i].Identifiers = identifiers[p.Type]
+	}
+
+	return ret
+}
@@ -0,0 +1,175 @@
+package iam_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestIAMPolicyStatementMerge(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Statement     *tfiam.IAMPolicyStatement
+		NewStatement  *tfiam.IAMPolicyStatement
+		ExpectedError *regexp.Regexp
+		ExpectedJSON  string
+	}{
+		{
+			TestName: "union",
+			Statement: &tfiam.IAMPolicyStatement{
+				Sid:       "test",
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: "*",
+			},
+			NewStatement: &tfiam.IAMPolicyStatement{
+				Sid:       "test",
+				Effect:    "Allow",
+				Actions:   []string{"s3:GetObject", "s3:PutObject"},
+				Resources: "*",
+			},
+			ExpectedJSON: `{"Sid":"test","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}`,
+		},
+		{
+			TestName: "different effects",
+			Statement: &tfiam.IAMPolicyStatement{
+				Sid:     "test",
+				Effect:  "Allow",
+				Actions: "s3:GetObject",
+			},
+			NewStatement: &tfiam.IAMPolicyStatement{
+				Sid:     "test",
+				Effect:  "Deny",
+				Actions: "s3:GetObject",
+			},
+			ExpectedError: regexp.MustCompile(`effects differ`),
+		},
+		{
+			TestName: "Action and NotAction",
+			Statement: &tfiam.IAMPolicyStatement{
+				Sid:     "test",
+				Effect:  "Allow",
+				Actions: "s3:GetObject",
+			},
+			NewStatement: &tfiam.IAMPolicyStatement{
+				Sid:        "test",
+				Effect:     "Allow",
+				NotActions: "s3:PutObject",
+			},
+			ExpectedError: regexp.MustCompile(`both Action and NotAction`),
+		},
+		{
+			TestName: "Resource and NotResource",
+			Statement: &tfiam.IAMPolicyStatement{
+				Sid:       "test",
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: "arn:aws:s3:::a/*", //lintignore:AWSAT005
+			},
+			NewStatement: &tfiam.IAMPolicyStatement{
+				Sid:          "test",
+				Effect:       "Allow",
+				Actions:      "s3:GetObject",
+				NotResources: "arn:aws:s3:::b/*", //lintignore:AWSAT005
+			},
+			ExpectedError: regexp.MustCompile(`both Resource and NotResource`),
+		},
+		{
+			TestName: "Principal and NotPrincipal",
+			Statement: &tfiam.IAMPolicyStatement{
+				Sid:        "test",
+				Effect:     "Allow",
+				Actions:    "s3:GetObject",
+				Principals: tfiam.IAMPolicyStatementPrincipalSet{{Type: "AWS", Identifiers: "123456789012"}},
+			},
+			NewStatement: &tfiam.IAMPolicyStatement{
+				Sid:           "test",
+				Effect:        "Allow",
+				Actions:       "s3:GetObject",
+				NotPrincipals: tfiam.IAMPolicyStatementPrincipalSet{{Type: "AWS", Identifiers: "210987654321"}},
+			},
+			ExpectedError: regexp.MustCompile(`both Principal and NotPrincipal`),
+		},
+		{
+			TestName: "same conditions",
+			Statement: &tfiam.IAMPolicyStatement{
+				Sid:        "test",
+				Effect:     "Allow",
+				Actions:    "s3:GetObject",
+				Conditions: tfiam.IAMPolicyStatementConditionSet{{Test: "Bool", Variable: "aws:SecureTransport", Values: "true"}},
+			},
+			NewStatement: &tfiam.IAMPolicyStatement{
+				Sid:        "test",
+				Effect:     "Allow",
+				Actions:    "s3:PutObject",
+				Conditions: tfiam.IAMPolicyStatementConditionSet{{Test: "Bool", Variable: "aws:SecureTransport", Values: "true"}},
+			},
+			ExpectedJSON: `{"Sid":"test","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Condition":{"Bool":{"aws:SecureTransport":"true"}}}`,
+		},
+		{
+			TestName: "conditions on one statement only",
+			Statement: &tfiam.IAMPolicyStatement{
+				Sid:     "test",
+				Effect:  "Allow",
+				Actions: "s3:GetObject",
+			},
+			NewStatement: &tfiam.IAMPolicyStatement{
+				Sid:        "test",
+				Effect:     "Allow",
+				Actions:    "s3:PutObject",
+				Conditions: tfiam.IAMPolicyStatementConditionSet{{Test: "Bool", Variable: "aws:SecureTransport", Values: "true"}},
+			},
+			ExpectedJSON: `{"Sid":"test","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Condition":{"Bool":{"aws:SecureTransport":"true"}}}`,
+		},
+		{
+			TestName: "different conditions",
+			Statement: &tfiam.IAMPolicyStatement{
+				Sid:        "test",
+				Effect:     "Allow",
+				Actions:    "s3:GetObject",
+				Conditions: tfiam.IAMPolicyStatementConditionSet{{Test: "Bool", Variable: "aws:SecureTransport", Values: "true"}},
+			},
+			NewStatement: &tfiam.IAMPolicyStatement{
+				Sid:        "test",
+				Effect:     "Allow",
+				Actions:    "s3:PutObject",
+				Conditions: tfiam.IAMPolicyStatementConditionSet{{Test: "StringEquals", Variable: "aws:PrincipalOrgID", Values: "o-1234567890"}},
+			},
+			ExpectedError: regexp.MustCompile(`conditions differ`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := testCase.Statement.Merge(testCase.NewStatement)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError, err)
+			}
+
+			if testCase.ExpectedJSON == "" {
+				return
+			}
+
+			got, err := json.Marshal(testCase.Statement)
+
+			if err != nil {
+				t.Fatalf("error marshaling statement: %s", err)
+			}
+
+			if string(got) != testCase.ExpectedJSON {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedJSON)
+			}
+		})
+	}
+}
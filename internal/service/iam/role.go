@@ -290,14 +290,20 @@ func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("unique_id", role.RoleId)
 
-	assumeRolePolicy, err := url.QueryUnescape(*role.AssumeRolePolicyDocument)
+	assumeRolePolicy, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
 	if err != nil {
-		return err
+		return fmt.Errorf("error parsing IAM Role (%s) assume role policy: %w", d.Id(), err)
 	}
-	if err := d.Set("assume_role_policy", assumeRolePolicy); err != nil {
-		return err
+
+	// Keep the configured document when the trust policy only differs in ordering or whitespace,
+	// so that only meaningful changes made outside Terraform are reported as drift.
+	policyToSet, err := verify.PolicyToSet(d.Get("assume_role_policy").(string), assumeRolePolicy)
+	if err != nil {
+		return fmt.Errorf("error setting IAM Role (%s) assume role policy: %w", d.Id(), err)
 	}
 
+	d.Set("assume_role_policy", policyToSet)
+
 	inlinePolicies, err := readRoleInlinePolicies(aws.StringValue(role.RoleName), meta)
 	if err != nil {
 		return fmt.Errorf("reading inline policies for IAM role %s, error: %s", d.Id(), err)
@@ -827,6 +827,57 @@ func TestAccIAMRole_ManagedPolicy_outOfBandAdditionRemovedEmpty(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_AssumeRolePolicy_outOfBandReorderIgnored(t *testing.T) {
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleAssumeRolePolicyMultipleStatementsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &role),
+					testAccCheckRoleUpdateAssumeRolePolicy(&role, testAccRoleAssumeRolePolicyReorderedDocument(acctest.AccountID())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRole_AssumeRolePolicy_outOfBandChangeDetected(t *testing.T) {
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleAssumeRolePolicyMultipleStatementsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &role),
+					testAccCheckRoleUpdateAssumeRolePolicy(&role, testAccRoleAssumeRolePolicyReorderedDocument("")),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRoleAssumeRolePolicyMultipleStatementsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &role),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRoleDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
 
@@ -1017,6 +1068,19 @@ func testAccCheckRolePolicyAddInlinePolicy(role *iam.Role, inlinePolicy string)
 	}
 }
 
+func testAccCheckRoleUpdateAssumeRolePolicy(role *iam.Role, policyDocument string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		_, err := conn.UpdateAssumeRolePolicy(&iam.UpdateAssumeRolePolicyInput{
+			PolicyDocument: aws.String(policyDocument),
+			RoleName:       role.RoleName,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckRolePolicyRemoveInlinePolicy(role *iam.Role, inlinePolicy string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
@@ -2236,3 +2300,58 @@ EOF
 }
 `, roleName, policyName)
 }
+
+func testAccRoleAssumeRolePolicyMultipleStatementsConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid    = "EC2"
+        Effect = "Allow"
+        Principal = {
+          AWS     = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+          Service = "ec2.${data.aws_partition.current.dns_suffix}"
+        }
+        Action = "sts:AssumeRole"
+      },
+      {
+        Sid    = "Lambda"
+        Effect = "Allow"
+        Principal = {
+          Service = "lambda.${data.aws_partition.current.dns_suffix}"
+        }
+        Action = "sts:AssumeRole"
+      },
+    ]
+  })
+}
+`, rName)
+}
+
+// testAccRoleAssumeRolePolicyReorderedDocument returns the trust policy of
+// testAccRoleAssumeRolePolicyMultipleStatementsConfig with its statements and
+// principal keys reordered. Without an account ID, the EC2 statement trusts
+// the EC2 service only.
+func testAccRoleAssumeRolePolicyReorderedDocument(accountID string) string {
+	ec2Principal := fmt.Sprintf(`{"Service":"ec2.%[1]s"}`, acctest.PartitionDNSSuffix())
+
+	if accountID != "" {
+		ec2Principal = fmt.Sprintf(`{"Service":"ec2.%[1]s","AWS":"arn:%[2]s:iam::%[3]s:root"}`, acctest.PartitionDNSSuffix(), acctest.Partition(), accountID)
+	}
+
+	return fmt.Sprintf(`{
+  "Statement": [
+    {"Action": "sts:AssumeRole", "Effect": "Allow", "Principal": {"Service": "lambda.%[1]s"}, "Sid": "Lambda"},
+    {"Action": "sts:AssumeRole", "Effect": "Allow", "Principal": %[2]s, "Sid": "EC2"}
+  ],
+  "Version": "2012-10-17"
+}`, acctest.PartitionDNSSuffix(), ec2Principal)
+}
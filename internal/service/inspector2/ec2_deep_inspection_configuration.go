@@ -0,0 +1,118 @@
+package inspector2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func ResourceEC2DeepInspectionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEC2DeepInspectionConfigurationPut,
+		Read:   resourceEC2DeepInspectionConfigurationRead,
+		Update: resourceEC2DeepInspectionConfigurationPut,
+		Delete: resourceEC2DeepInspectionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_package_paths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"package_paths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEC2DeepInspectionConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	id := d.Id()
+
+	if d.IsNewResource() {
+		id = meta.(*conns.AWSClient).AccountID
+	}
+
+	input := &inspector2.UpdateEc2DeepInspectionConfigurationInput{
+		ActivateDeepInspection: aws.Bool(true),
+		PackagePaths:           flex.ExpandStringList(d.Get("package_paths").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Inspector2 EC2 Deep Inspection Configuration: %s", input)
+	_, err := conn.UpdateEc2DeepInspectionConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Inspector2 EC2 Deep Inspection Configuration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitEC2DeepInspectionConfigurationActivated(conn); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 EC2 Deep Inspection Configuration (%s) activate: %w", d.Id(), err)
+	}
+
+	return resourceEC2DeepInspectionConfigurationRead(d, meta)
+}
+
+func resourceEC2DeepInspectionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	output, err := FindEC2DeepInspectionConfiguration(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 EC2 Deep Inspection Configuration (%s): %w", d.Id(), err)
+	}
+
+	if !d.IsNewResource() && aws.StringValue(output.Status) == inspector2.Ec2DeepInspectionStatusDeactivated {
+		log.Printf("[WARN] Inspector2 EC2 Deep Inspection Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("org_package_paths", aws.StringValueSlice(output.OrgPackagePaths))
+	d.Set("package_paths", aws.StringValueSlice(output.PackagePaths))
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceEC2DeepInspectionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	log.Printf("[DEBUG] Deactivating Inspector2 EC2 Deep Inspection Configuration: %s", d.Id())
+	_, err := conn.UpdateEc2DeepInspectionConfiguration(&inspector2.UpdateEc2DeepInspectionConfigurationInput{
+		ActivateDeepInspection: aws.Bool(false),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deactivating Inspector2 EC2 Deep Inspection Configuration (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEC2DeepInspectionConfigurationDeactivated(conn); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 EC2 Deep Inspection Configuration (%s) deactivate: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package inspector2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+)
+
+// EC2 deep inspection is configured per account, so these tests cannot run in parallel.
+func TestAccInspector2EC2DeepInspectionConfiguration_basic(t *testing.T) {
+	resourceName := "aws_inspector2_ec2_deep_inspection_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, inspector2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEC2DeepInspectionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEC2DeepInspectionConfigurationConfig(`"/opt/app/lib"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEC2DeepInspectionConfigurationExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "package_paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "package_paths.0", "/opt/app/lib"),
+					resource.TestCheckResourceAttr(resourceName, "status", inspector2.Ec2DeepInspectionStatusActivated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEC2DeepInspectionConfigurationConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEC2DeepInspectionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "package_paths.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", inspector2.Ec2DeepInspectionStatusActivated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEC2DeepInspectionConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector2 EC2 Deep Inspection Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		output, err := tfinspector2.FindEC2DeepInspectionConfiguration(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Status); status != inspector2.Ec2DeepInspectionStatusActivated {
+			return fmt.Errorf("Inspector2 EC2 Deep Inspection Configuration (%s) is %s", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEC2DeepInspectionConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_ec2_deep_inspection_configuration" {
+			continue
+		}
+
+		output, err := tfinspector2.FindEC2DeepInspectionConfiguration(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Status); status != inspector2.Ec2DeepInspectionStatusDeactivated {
+			return fmt.Errorf("Inspector2 EC2 Deep Inspection Configuration (%s) is still %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccEC2DeepInspectionConfigurationConfig(packagePaths string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = [data.aws_caller_identity.current.account_id]
+  resource_types = ["EC2"]
+}
+
+resource "aws_inspector2_ec2_deep_inspection_configuration" "test" {
+  package_paths = [%[1]s]
+
+  depends_on = [aws_inspector2_enabler.test]
+}
+`, packagePaths)
+}
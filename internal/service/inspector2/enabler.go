@@ -0,0 +1,242 @@
+package inspector2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const enablerIDSeparator = ","
+
+func ResourceEnabler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnablerCreate,
+		Read:   resourceEnablerRead,
+		Update: resourceEnablerUpdate,
+		Delete: resourceEnablerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("account_ids", strings.Split(d.Id(), enablerIDSeparator))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: resourceEnablerCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(inspector2.ResourceScanType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceEnablerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs := aws.StringValueSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+	resourceTypes := aws.StringValueSlice(flex.ExpandStringSet(d.Get("resource_types").(*schema.Set)))
+	id := enablerCreateResourceID(accountIDs)
+
+	if err := enableResourceTypes(conn, accountIDs, resourceTypes); err != nil {
+		return fmt.Errorf("error creating Inspector2 Enabler (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := waitEnablerEnabled(conn, accountIDs, resourceTypes); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Enabler (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceEnablerRead(d, meta)
+}
+
+func resourceEnablerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs := strings.Split(d.Id(), enablerIDSeparator)
+	states, err := FindAccountResourceStatesByAccountIDs(conn, accountIDs)
+
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 Enabler (%s): %w", d.Id(), err)
+	}
+
+	// Only report the resource types that are enabled in every account.
+	var resourceTypes []string
+
+	for _, resourceType := range inspector2.ResourceScanType_Values() {
+		enabled := true
+
+		for _, accountID := range accountIDs {
+			state, ok := states[accountID]
+
+			if !ok || resourceTypeStatus(state, resourceType) != inspector2.StatusEnabled {
+				enabled = false
+				break
+			}
+		}
+
+		if enabled {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+
+	if !d.IsNewResource() && len(resourceTypes) == 0 {
+		log.Printf("[WARN] Inspector2 Enabler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_ids", accountIDs)
+	d.Set("resource_types", resourceTypes)
+
+	return nil
+}
+
+func resourceEnablerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	if d.HasChange("resource_types") {
+		accountIDs := aws.StringValueSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+		o, n := d.GetChange("resource_types")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Enable before disabling so that LAMBDA_CODE never remains enabled without LAMBDA.
+		if add := aws.StringValueSlice(flex.ExpandStringSet(ns.Difference(os))); len(add) > 0 {
+			if err := enableResourceTypes(conn, accountIDs, add); err != nil {
+				return fmt.Errorf("error updating Inspector2 Enabler (%s): %w", d.Id(), err)
+			}
+
+			if err := waitEnablerEnabled(conn, accountIDs, add); err != nil {
+				return fmt.Errorf("error waiting for Inspector2 Enabler (%s) update: %w", d.Id(), err)
+			}
+		}
+
+		if del := aws.StringValueSlice(flex.ExpandStringSet(os.Difference(ns))); len(del) > 0 {
+			if err := disableResourceTypes(conn, accountIDs, del); err != nil {
+				return fmt.Errorf("error updating Inspector2 Enabler (%s): %w", d.Id(), err)
+			}
+
+			if err := waitEnablerDisabled(conn, accountIDs, del); err != nil {
+				return fmt.Errorf("error waiting for Inspector2 Enabler (%s) update: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceEnablerRead(d, meta)
+}
+
+func resourceEnablerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs := aws.StringValueSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+	resourceTypes := aws.StringValueSlice(flex.ExpandStringSet(d.Get("resource_types").(*schema.Set)))
+
+	log.Printf("[DEBUG] Deleting Inspector2 Enabler: %s", d.Id())
+	if err := disableResourceTypes(conn, accountIDs, resourceTypes); err != nil {
+		return fmt.Errorf("error deleting Inspector2 Enabler (%s): %w", d.Id(), err)
+	}
+
+	if err := waitEnablerDisabled(conn, accountIDs, resourceTypes); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Enabler (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEnablerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	resourceTypes := diff.Get("resource_types").(*schema.Set)
+
+	// Lambda code scanning requires Lambda standard scanning.
+	if resourceTypes.Contains(inspector2.ResourceScanTypeLambdaCode) && !resourceTypes.Contains(inspector2.ResourceScanTypeLambda) {
+		return fmt.Errorf("resource_types: %s requires %s to also be enabled", inspector2.ResourceScanTypeLambdaCode, inspector2.ResourceScanTypeLambda)
+	}
+
+	return nil
+}
+
+func enableResourceTypes(conn *inspector2.Inspector2, accountIDs, resourceTypes []string) error {
+	input := &inspector2.EnableInput{
+		AccountIds:    aws.StringSlice(accountIDs),
+		ResourceTypes: aws.StringSlice(resourceTypes),
+	}
+
+	log.Printf("[DEBUG] Enabling Inspector2 resource types: %s", input)
+	output, err := conn.Enable(input)
+
+	if err != nil {
+		return err
+	}
+
+	return failedAccountsError(output.FailedAccounts)
+}
+
+func disableResourceTypes(conn *inspector2.Inspector2, accountIDs, resourceTypes []string) error {
+	input := &inspector2.DisableInput{
+		AccountIds:    aws.StringSlice(accountIDs),
+		ResourceTypes: aws.StringSlice(resourceTypes),
+	}
+
+	log.Printf("[DEBUG] Disabling Inspector2 resource types: %s", input)
+	output, err := conn.Disable(input)
+
+	if err != nil {
+		return err
+	}
+
+	return failedAccountsError(output.FailedAccounts)
+}
+
+func failedAccountsError(apiObjects []*inspector2.FailedAccount) error {
+	var errs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("account %s: %s: %s", aws.StringValue(apiObject.AccountId), aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func enablerCreateResourceID(accountIDs []string) string {
+	ids := make([]string, len(accountIDs))
+	copy(ids, accountIDs)
+	sort.Strings(ids)
+
+	return strings.Join(ids, enablerIDSeparator)
+}
@@ -0,0 +1,172 @@
+package inspector2_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+)
+
+// Inspector2 enablement is account-wide, so these tests cannot run in parallel.
+func TestAccInspector2Enabler_lambdaCode(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, inspector2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnablerConfig(`"LAMBDA"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName, []string{inspector2.ResourceScanTypeLambda}),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", inspector2.ResourceScanTypeLambda),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnablerConfig(`"LAMBDA", "LAMBDA_CODE"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName, []string{inspector2.ResourceScanTypeLambda, inspector2.ResourceScanTypeLambdaCode}),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", inspector2.ResourceScanTypeLambda),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", inspector2.ResourceScanTypeLambdaCode),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInspector2Enabler_lambdaCodeWithoutLambda(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, inspector2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnablerConfig(`"LAMBDA_CODE"`),
+				ExpectError: regexp.MustCompile(`LAMBDA_CODE requires LAMBDA to also be enabled`),
+			},
+		},
+	})
+}
+
+func testAccCheckEnablerExists(n string, resourceTypes []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector2 Enabler ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		accountIDs := strings.Split(rs.Primary.ID, ",")
+		states, err := tfinspector2.FindAccountResourceStatesByAccountIDs(conn, accountIDs)
+
+		if err != nil {
+			return err
+		}
+
+		for _, accountID := range accountIDs {
+			state, ok := states[accountID]
+
+			if !ok {
+				return fmt.Errorf("Inspector2 account status (%s) not found", accountID)
+			}
+
+			for _, resourceType := range resourceTypes {
+				if status := testAccEnablerResourceTypeStatus(state, resourceType); status != inspector2.StatusEnabled {
+					return fmt.Errorf("Inspector2 %s scanning in account %s is %s", resourceType, accountID, status)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnablerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_enabler" {
+			continue
+		}
+
+		accountIDs := strings.Split(rs.Primary.ID, ",")
+		states, err := tfinspector2.FindAccountResourceStatesByAccountIDs(conn, accountIDs)
+
+		if err != nil {
+			return err
+		}
+
+		var resourceTypes []string
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "resource_types.") && k != "resource_types.#" {
+				resourceTypes = append(resourceTypes, v)
+			}
+		}
+
+		for accountID, state := range states {
+			for _, resourceType := range resourceTypes {
+				if status := testAccEnablerResourceTypeStatus(state, resourceType); status != inspector2.StatusDisabled {
+					return fmt.Errorf("Inspector2 %s scanning in account %s is still %s", resourceType, accountID, status)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccEnablerResourceTypeStatus(state *inspector2.ResourceState, resourceType string) string {
+	var v *inspector2.State
+
+	switch resourceType {
+	case inspector2.ResourceScanTypeEc2:
+		v = state.Ec2
+	case inspector2.ResourceScanTypeEcr:
+		v = state.Ecr
+	case inspector2.ResourceScanTypeLambda:
+		v = state.Lambda
+	case inspector2.ResourceScanTypeLambdaCode:
+		v = state.LambdaCode
+	}
+
+	if v == nil || v.Status == nil {
+		return inspector2.StatusDisabled
+	}
+
+	return *v.Status
+}
+
+func testAccEnablerConfig(resourceTypes string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = [data.aws_caller_identity.current.account_id]
+  resource_types = [%[1]s]
+}
+`, resourceTypes)
+}
@@ -0,0 +1,62 @@
+package inspector2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// FindAccountResourceStatesByAccountIDs returns the scan status of each resource type, keyed by account ID.
+func FindAccountResourceStatesByAccountIDs(conn *inspector2.Inspector2, accountIDs []string) (map[string]*inspector2.ResourceState, error) {
+	input := &inspector2.BatchGetAccountStatusInput{
+		AccountIds: aws.StringSlice(accountIDs),
+	}
+
+	output, err := conn.BatchGetAccountStatus(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.FailedAccounts {
+		if v == nil {
+			continue
+		}
+
+		return nil, fmt.Errorf("account %s: %s: %s", aws.StringValue(v.AccountId), aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage))
+	}
+
+	states := make(map[string]*inspector2.ResourceState)
+
+	for _, v := range output.Accounts {
+		if v == nil || v.ResourceState == nil {
+			continue
+		}
+
+		states[aws.StringValue(v.AccountId)] = v.ResourceState
+	}
+
+	return states, nil
+}
+
+func FindEC2DeepInspectionConfiguration(conn *inspector2.Inspector2) (*inspector2.GetEc2DeepInspectionConfigurationOutput, error) {
+	input := &inspector2.GetEc2DeepInspectionConfigurationInput{}
+
+	output, err := conn.GetEc2DeepInspectionConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,79 @@
+package inspector2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// enablerStatusMixed is returned while the requested resource types are not all in the same state.
+	enablerStatusMixed = "MIXED"
+)
+
+// statusEnabler returns the common scan status of the specified resource types across all of the specified accounts.
+func statusEnabler(conn *inspector2.Inspector2, accountIDs, resourceTypes []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		states, err := FindAccountResourceStatesByAccountIDs(conn, accountIDs)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var status string
+
+		for _, accountID := range accountIDs {
+			state, ok := states[accountID]
+
+			if !ok {
+				return nil, "", nil
+			}
+
+			for _, resourceType := range resourceTypes {
+				s := resourceTypeStatus(state, resourceType)
+
+				if status == "" {
+					status = s
+				} else if status != s {
+					return states, enablerStatusMixed, nil
+				}
+			}
+		}
+
+		return states, status, nil
+	}
+}
+
+// resourceTypeStatus returns the scan status of a single resource type.
+func resourceTypeStatus(state *inspector2.ResourceState, resourceType string) string {
+	var v *inspector2.State
+
+	switch resourceType {
+	case inspector2.ResourceScanTypeEc2:
+		v = state.Ec2
+	case inspector2.ResourceScanTypeEcr:
+		v = state.Ecr
+	case inspector2.ResourceScanTypeLambda:
+		v = state.Lambda
+	case inspector2.ResourceScanTypeLambdaCode:
+		v = state.LambdaCode
+	}
+
+	if v == nil {
+		return inspector2.StatusDisabled
+	}
+
+	return aws.StringValue(v.Status)
+}
+
+func statusEC2DeepInspectionConfiguration(conn *inspector2.Inspector2) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEC2DeepInspectionConfiguration(conn)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
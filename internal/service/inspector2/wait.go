@@ -0,0 +1,89 @@
+package inspector2
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	enablerEnabledTimeout  = 15 * time.Minute
+	enablerDisabledTimeout = 15 * time.Minute
+
+	ec2DeepInspectionConfigurationActivatedTimeout   = 5 * time.Minute
+	ec2DeepInspectionConfigurationDeactivatedTimeout = 5 * time.Minute
+)
+
+func waitEnablerEnabled(conn *inspector2.Inspector2, accountIDs, resourceTypes []string) error {
+	stateConf := &resource.StateChangeConf{
+		// The previous status may be reported for a short time after enabling.
+		Pending: []string{inspector2.StatusEnabling, inspector2.StatusDisabled, inspector2.StatusDisabling, enablerStatusMixed},
+		Target:  []string{inspector2.StatusEnabled},
+		Refresh: statusEnabler(conn, accountIDs, resourceTypes),
+		Timeout: enablerEnabledTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitEnablerDisabled(conn *inspector2.Inspector2, accountIDs, resourceTypes []string) error {
+	stateConf := &resource.StateChangeConf{
+		// The previous status may be reported for a short time after disabling.
+		Pending: []string{inspector2.StatusDisabling, inspector2.StatusEnabled, inspector2.StatusEnabling, enablerStatusMixed},
+		Target:  []string{inspector2.StatusDisabled},
+		Refresh: statusEnabler(conn, accountIDs, resourceTypes),
+		Timeout: enablerDisabledTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitEC2DeepInspectionConfigurationActivated(conn *inspector2.Inspector2) (*inspector2.GetEc2DeepInspectionConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.Ec2DeepInspectionStatusPending, inspector2.Ec2DeepInspectionStatusDeactivated},
+		Target:  []string{inspector2.Ec2DeepInspectionStatusActivated},
+		Refresh: statusEC2DeepInspectionConfiguration(conn),
+		Timeout: ec2DeepInspectionConfigurationActivatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.GetEc2DeepInspectionConfigurationOutput); ok {
+		if aws.StringValue(output.Status) == inspector2.Ec2DeepInspectionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEC2DeepInspectionConfigurationDeactivated(conn *inspector2.Inspector2) (*inspector2.GetEc2DeepInspectionConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.Ec2DeepInspectionStatusPending, inspector2.Ec2DeepInspectionStatusActivated},
+		Target:  []string{inspector2.Ec2DeepInspectionStatusDeactivated},
+		Refresh: statusEC2DeepInspectionConfiguration(conn),
+		Timeout: ec2DeepInspectionConfigurationDeactivatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.GetEc2DeepInspectionConfigurationOutput); ok {
+		if aws.StringValue(output.Status) == inspector2.Ec2DeepInspectionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,5 +1,9 @@
 package kms
 
+import (
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
 const (
 	AliasNamePrefix = "alias/"
 )
@@ -7,3 +11,13 @@ const (
 const (
 	PolicyNameDefault = "default"
 )
+
+// KeyOrigin_Values returns the key origins supported by aws_kms_key.
+// Keys with imported key material (EXTERNAL) are managed by aws_kms_external_key.
+func KeyOrigin_Values() []string {
+	return []string{
+		kms.OriginTypeAwsCloudhsm,
+		kms.OriginTypeAwsKms,
+		kms.OriginTypeExternalKeyStore,
+	}
+}
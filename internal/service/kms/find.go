@@ -124,3 +124,28 @@ func FindKeyRotationEnabledByKeyID(conn *kms.KMS, keyID string) (*bool, error) {
 
 	return output.KeyRotationEnabled, nil
 }
+
+func FindCustomKeyStoreByID(conn *kms.KMS, id string) (*kms.CustomKeyStoresListEntry, error) {
+	input := &kms.DescribeCustomKeyStoresInput{
+		CustomKeyStoreId: aws.String(id),
+	}
+
+	output, err := conn.DescribeCustomKeyStores(input)
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CustomKeyStores) == 0 || output.CustomKeyStores[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CustomKeyStores[0], nil
+}
@@ -1,12 +1,15 @@
 package kms
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -28,7 +31,10 @@ func ResourceKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceKeyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -40,6 +46,12 @@ func ResourceKey() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"custom_key_store_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
 			"customer_master_key_spec": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -85,6 +97,13 @@ func ResourceKey() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"origin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(KeyOrigin_Values(), false),
+			},
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -94,6 +113,36 @@ func ResourceKey() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"xks_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"xks_proxy_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connectivity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_endpoint_service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -109,6 +158,10 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		KeyUsage:                       aws.String(d.Get("key_usage").(string)),
 	}
 
+	if v, ok := d.GetOk("custom_key_store_id"); ok {
+		input.CustomKeyStoreId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -117,6 +170,10 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		input.MultiRegion = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("origin"); ok {
+		input.Origin = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("policy"); ok {
 		input.Policy = aws.String(v.(string))
 	}
@@ -125,6 +182,10 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	if v, ok := d.GetOk("xks_key_id"); ok {
+		input.XksKeyId = aws.String(v.(string))
+	}
+
 	// AWS requires any principal in the policy to exist before the key is created.
 	// The KMS service's awareness of principals is limited by "eventual consistency".
 	// They acknowledge this here:
@@ -192,6 +253,7 @@ func resourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", key.metadata.Arn)
+	d.Set("custom_key_store_id", key.metadata.CustomKeyStoreId)
 	d.Set("customer_master_key_spec", key.metadata.CustomerMasterKeySpec)
 	d.Set("description", key.metadata.Description)
 	d.Set("enable_key_rotation", key.rotation)
@@ -199,6 +261,21 @@ func resourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_id", key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
+	d.Set("origin", key.metadata.Origin)
+
+	if key.metadata.XksKeyConfiguration != nil {
+		d.Set("xks_key_id", key.metadata.XksKeyConfiguration.Id)
+	} else {
+		d.Set("xks_key_id", nil)
+	}
+
+	if key.xksProxyConfiguration != nil {
+		if err := d.Set("xks_proxy_configuration", []interface{}{flattenXksProxyConfiguration(key.xksProxyConfiguration)}); err != nil {
+			return fmt.Errorf("error setting xks_proxy_configuration: %w", err)
+		}
+	} else {
+		d.Set("xks_proxy_configuration", nil)
+	}
 
 	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), key.policy)
 
@@ -306,10 +383,11 @@ func resourceKeyDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 type kmsKey struct {
-	metadata *kms.KeyMetadata
-	policy   string
-	rotation *bool
-	tags     tftags.KeyValueTags
+	metadata              *kms.KeyMetadata
+	policy                string
+	rotation              *bool
+	tags                  tftags.KeyValueTags
+	xksProxyConfiguration *kms.XksProxyConfigurationType
 }
 
 func findKmsKey(conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error) {
@@ -344,6 +422,17 @@ func findKmsKey(conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error
 			}
 		}
 
+		if aws.StringValue(key.metadata.Origin) == kms.OriginTypeExternalKeyStore {
+			customKeyStoreID := aws.StringValue(key.metadata.CustomKeyStoreId)
+			customKeyStore, err := FindCustomKeyStoreByID(conn, customKeyStoreID)
+
+			if err != nil {
+				return nil, fmt.Errorf("error reading KMS Custom Key Store (%s): %w", customKeyStoreID, err)
+			}
+
+			key.xksProxyConfiguration = customKeyStore.XksProxyConfiguration
+		}
+
 		key.tags, err = ListTags(conn, keyID)
 
 		if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
@@ -364,6 +453,90 @@ func findKmsKey(conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error
 	return outputRaw.(*kmsKey), nil
 }
 
+func resourceKeyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// origin is Computed, so the configuration is checked rather than the planned values.
+	// An omitted origin defaults to AWS_KMS.
+	rawConfig := diff.GetRawConfig()
+
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	origin, ok := keyRawConfigString(rawConfig, "origin", kms.OriginTypeAwsKms)
+	if !ok {
+		return nil
+	}
+
+	customKeyStoreID, ok := keyRawConfigString(rawConfig, "custom_key_store_id", "")
+	if !ok {
+		return nil
+	}
+
+	xksKeyID, ok := keyRawConfigString(rawConfig, "xks_key_id", "")
+	if !ok {
+		return nil
+	}
+
+	switch origin {
+	case kms.OriginTypeExternalKeyStore:
+		if customKeyStoreID == "" || xksKeyID == "" {
+			return fmt.Errorf("custom_key_store_id and xks_key_id are required when origin is %s", origin)
+		}
+	case kms.OriginTypeAwsCloudhsm:
+		if customKeyStoreID == "" {
+			return fmt.Errorf("custom_key_store_id is required when origin is %s", origin)
+		}
+	}
+
+	if xksKeyID != "" && origin != kms.OriginTypeExternalKeyStore {
+		return fmt.Errorf("xks_key_id can only be set when origin is %s", kms.OriginTypeExternalKeyStore)
+	}
+
+	return nil
+}
+
+// keyRawConfigString returns the configured string value of the named attribute,
+// or defaultValue if it is not configured. It returns false if the value is unknown.
+func keyRawConfigString(rawConfig cty.Value, name, defaultValue string) (string, bool) {
+	v := rawConfig.GetAttr(name)
+
+	if !v.IsKnown() {
+		return "", false
+	}
+
+	if v.IsNull() {
+		return defaultValue, true
+	}
+
+	return v.AsString(), true
+}
+
+func flattenXksProxyConfiguration(apiObject *kms.XksProxyConfigurationType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Connectivity; v != nil {
+		tfMap["connectivity"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UriEndpoint; v != nil {
+		tfMap["uri_endpoint"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UriPath; v != nil {
+		tfMap["uri_path"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VpcEndpointServiceName; v != nil {
+		tfMap["vpc_endpoint_service_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func updateKmsKeyDescription(conn *kms.KMS, keyID string, description string) error {
 	input := &kms.UpdateKeyDescriptionInput{
 		Description: aws.String(description),
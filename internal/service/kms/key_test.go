@@ -2,6 +2,7 @@ package kms_test
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
@@ -121,6 +122,62 @@ func TestAccKMSKey_asymmetricKey(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_externalKeyStore(t *testing.T) {
+	customKeyStoreID := os.Getenv("KMS_XKS_CUSTOM_KEY_STORE_ID")
+	xksKeyID := os.Getenv("KMS_XKS_KEY_ID")
+
+	if customKeyStoreID == "" || xksKeyID == "" {
+		t.Skip("Environment variables KMS_XKS_CUSTOM_KEY_STORE_ID and KMS_XKS_KEY_ID are not set")
+	}
+
+	var key kms.KeyMetadata
+	resourceName := "aws_kms_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyExternalKeyStoreConfig(rName, customKeyStoreID, xksKeyID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_id", customKeyStoreID),
+					resource.TestCheckResourceAttr(resourceName, "origin", kms.OriginTypeExternalKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "xks_key_id", xksKeyID),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "xks_proxy_configuration.0.connectivity"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+			},
+		},
+	})
+}
+
+func TestAccKMSKey_externalKeyStoreValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyXksKeyIDWithoutOriginConfig(rName),
+				ExpectError: regexp.MustCompile(`xks_key_id can only be set when origin is EXTERNAL_KEY_STORE`),
+			},
+		},
+	})
+}
+
 func TestAccKMSKey_Policy_basic(t *testing.T) {
 	var key kms.KeyMetadata
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -520,6 +577,30 @@ resource "aws_kms_key" "test" {}
 `
 }
 
+func testAccKeyExternalKeyStoreConfig(rName, customKeyStoreID, xksKeyID string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  custom_key_store_id = %[2]q
+  origin              = "EXTERNAL_KEY_STORE"
+  xks_key_id          = %[3]q
+}
+`, rName, customKeyStoreID, xksKeyID)
+}
+
+func testAccKeyXksKeyIDWithoutOriginConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  xks_key_id = "bb8562717f809024"
+}
+`, rName)
+}
+
 func testAccKeyNameConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -1,6 +1,8 @@
 package lambda
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
@@ -32,6 +34,8 @@ func ResourceEventSourceMapping() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceEventSourceMappingCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"batch_size": {
 				Type:     schema.TypeInt,
@@ -211,6 +215,29 @@ func ResourceEventSourceMapping() *schema.Resource {
 				},
 			},
 
+			"scaling_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntBetween(2, 1000)),
+						},
+					},
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// An unset or zero maximum_concurrency is equivalent to no scaling configuration.
+					if d.Get("scaling_config.0.maximum_concurrency").(int) != 0 {
+						return false
+					}
+
+					return old == "" || old == "0"
+				},
+			},
+
 			"self_managed_event_source": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -367,6 +394,10 @@ func resourceEventSourceMappingCreate(d *schema.ResourceData, meta interface{})
 		input.Queues = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ScalingConfig = expandScalingConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("self_managed_event_source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.SelfManagedEventSource = expandSelfManagedEventSource(v.([]interface{})[0].(map[string]interface{}))
 
@@ -491,6 +522,13 @@ func resourceEventSourceMappingRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("maximum_retry_attempts", eventSourceMappingConfiguration.MaximumRetryAttempts)
 	d.Set("parallelization_factor", eventSourceMappingConfiguration.ParallelizationFactor)
 	d.Set("queues", aws.StringValueSlice(eventSourceMappingConfiguration.Queues))
+	if v := eventSourceMappingConfiguration.ScalingConfig; v != nil && v.MaximumConcurrency != nil {
+		if err := d.Set("scaling_config", []interface{}{flattenScalingConfig(v)}); err != nil {
+			return fmt.Errorf("error setting scaling_config: %w", err)
+		}
+	} else {
+		d.Set("scaling_config", nil)
+	}
 	if eventSourceMappingConfiguration.SelfManagedEventSource != nil {
 		if err := d.Set("self_managed_event_source", []interface{}{flattenSelfManagedEventSource(eventSourceMappingConfiguration.SelfManagedEventSource)}); err != nil {
 			return fmt.Errorf("error setting self_managed_event_source: %w", err)
@@ -586,6 +624,15 @@ func resourceEventSourceMappingUpdate(d *schema.ResourceData, meta interface{})
 		input.ParallelizationFactor = aws.Int64(int64(d.Get("parallelization_factor").(int)))
 	}
 
+	if d.HasChange("scaling_config") {
+		if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ScalingConfig = expandScalingConfig(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			// AWS ignores the removal if this is left as nil.
+			input.ScalingConfig = &lambda.ScalingConfig{}
+		}
+	}
+
 	if d.HasChange("source_access_configuration") {
 		if v, ok := d.GetOk("source_access_configuration"); ok && v.(*schema.Set).Len() > 0 {
 			input.SourceAccessConfigurations = expandSourceAccessConfigurations(v.(*schema.Set).List())
@@ -667,6 +714,28 @@ func resourceEventSourceMappingDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceEventSourceMappingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("scaling_config.0.maximum_concurrency").(int) == 0 {
+		return nil
+	}
+
+	if _, ok := diff.GetOk("self_managed_event_source"); ok {
+		return errors.New("scaling_config is only supported for Amazon SQS event sources")
+	}
+
+	if !diff.NewValueKnown("event_source_arn") {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("event_source_arn"); ok {
+		if eventSourceARN, err := arn.Parse(v.(string)); err == nil && eventSourceARN.Service != "sqs" {
+			return errors.New("scaling_config is only supported for Amazon SQS event sources")
+		}
+	}
+
+	return nil
+}
+
 func expandDestinationConfig(tfMap map[string]interface{}) *lambda.DestinationConfig {
 	if tfMap == nil {
 		return nil
@@ -723,6 +792,34 @@ func flattenOnFailure(apiObject *lambda.OnFailure) map[string]interface{} {
 	return tfMap
 }
 
+func expandScalingConfig(tfMap map[string]interface{}) *lambda.ScalingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.ScalingConfig{}
+
+	if v, ok := tfMap["maximum_concurrency"].(int); ok && v != 0 {
+		apiObject.MaximumConcurrency = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenScalingConfig(apiObject *lambda.ScalingConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaximumConcurrency; v != nil {
+		tfMap["maximum_concurrency"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
 func expandSelfManagedEventSource(tfMap map[string]interface{}) *lambda.SelfManagedEventSource {
 	if tfMap == nil {
 		return nil
@@ -281,6 +281,25 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"snap_start": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressSnapStartApplyOnNone,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_on": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lambda.SnapStartApplyOn_Values(), false),
+						},
+						"optimization_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"source_code_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -381,7 +400,8 @@ func hasConfigChanges(d verify.ResourceDiffer) bool {
 		d.HasChange("vpc_config.0.security_group_ids") ||
 		d.HasChange("vpc_config.0.subnet_ids") ||
 		d.HasChange("runtime") ||
-		d.HasChange("environment")
+		d.HasChange("environment") ||
+		d.HasChange("snap_start")
 }
 
 // resourceAwsLambdaFunction maps to:
@@ -526,12 +546,18 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		params.KMSKeyArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 {
+		params.SnapStart = expandSnapStart(v.([]interface{}))
+	}
+
 	if len(tags) > 0 {
 		params.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	var output *lambda.FunctionConfiguration
 	err := resource.Retry(lambdaFunctionCreateTimeout, func() *resource.RetryError { // nosem: helper-schema-resource-Retry-without-TimeoutError-check
-		_, err := conn.CreateFunction(params)
+		var err error
+		output, err = conn.CreateFunction(params)
 
 		if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "The role defined for the function cannot be assumed by Lambda") {
 			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
@@ -561,7 +587,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateFunction(params)
+		output, err = conn.CreateFunction(params)
 	}
 
 	if err != nil {
@@ -570,7 +596,8 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		err := resource.Retry(lambdaFunctionExtraThrottlingTimeout, func() *resource.RetryError {
-			_, err := conn.CreateFunction(params)
+			var err error
+			output, err = conn.CreateFunction(params)
 
 			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "throttled by EC2") {
 				log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
@@ -585,7 +612,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.CreateFunction(params)
+			output, err = conn.CreateFunction(params)
 		}
 
 		if err != nil {
@@ -599,6 +626,13 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for Lambda Function (%s) creation: %w", d.Id(), err)
 	}
 
+	// With SnapStart, a published version is not invocable until its snapshot has been created.
+	if d.Get("publish").(bool) && snapStartEnabled(d.Get("snap_start").([]interface{})) {
+		if err := waitForFunctionPublishedVersionActive(conn, d.Id(), aws.StringValue(output.Version), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lambda Function (%s) version (%s) SnapStart optimization: %w", d.Id(), aws.StringValue(output.Version), err)
+		}
+	}
+
 	if reservedConcurrentExecutions >= 0 {
 
 		log.Printf("[DEBUG] Setting Concurrency to %d for the Lambda Function %s", reservedConcurrentExecutions, functionName)
@@ -813,6 +847,10 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		},
 	})
 
+	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
+		return fmt.Errorf("error setting snap_start for Lambda Function (%s): %w", d.Id(), err)
+	}
+
 	// Get latest version and ARN unless qualifier is specified via data source
 	if qualifierExistance {
 		d.Set("version", function.Version)
@@ -1049,6 +1087,14 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("runtime") {
 		configReq.Runtime = aws.String(d.Get("runtime").(string))
 	}
+	if d.HasChange("snap_start") {
+		configReq.SnapStart = &lambda.SnapStart{
+			ApplyOn: aws.String(lambda.SnapStartApplyOnNone),
+		}
+		if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 {
+			configReq.SnapStart = expandSnapStart(v.([]interface{}))
+		}
+	}
 	if d.HasChange("environment") {
 		if v, ok := d.GetOk("environment"); ok {
 			environments := v.([]interface{})
@@ -1260,6 +1306,13 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("while waiting for function (%s) update: %w", d.Id(), err)
 		}
+
+		// With SnapStart, a published version is not invocable until its snapshot has been created.
+		if snapStartEnabled(d.Get("snap_start").([]interface{})) {
+			if err := waitForFunctionPublishedVersionActive(conn, d.Id(), aws.StringValue(output.Version), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Lambda Function (%s) version (%s) SnapStart optimization: %w", d.Id(), aws.StringValue(output.Version), err)
+			}
+		}
 	}
 
 	return resourceFunctionRead(d, meta)
@@ -1377,6 +1430,95 @@ func waitForFunctionUpdate(conn *lambda.Lambda, functionName string, timeout tim
 	return err
 }
 
+func waitForFunctionPublishedVersionActive(conn *lambda.Lambda, functionName, version string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lambda.StatePending},
+		Target:  []string{lambda.StateActive},
+		Refresh: refreshFunctionVersionState(conn, functionName, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func refreshFunctionVersionState(conn *lambda.Lambda, functionName, version string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &lambda.GetFunctionConfigurationInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(version),
+		}
+
+		output, err := conn.GetFunctionConfiguration(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		state := aws.StringValue(output.State)
+
+		if state == lambda.StateFailed {
+			return output, state, fmt.Errorf("%s: %s", aws.StringValue(output.StateReasonCode), aws.StringValue(output.StateReason))
+		}
+
+		return output, state, nil
+	}
+}
+
+func expandSnapStart(tfList []interface{}) *lambda.SnapStart {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &lambda.SnapStart{}
+
+	if v, ok := tfMap["apply_on"].(string); ok && v != "" {
+		apiObject.ApplyOn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSnapStart(apiObject *lambda.SnapStartResponse) []interface{} {
+	// SnapStart turned off is reported as ApplyOn None, which is equivalent to no configuration block.
+	if apiObject == nil || aws.StringValue(apiObject.ApplyOn) == lambda.SnapStartApplyOnNone {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"apply_on":            aws.StringValue(apiObject.ApplyOn),
+		"optimization_status": aws.StringValue(apiObject.OptimizationStatus),
+	}
+
+	return []interface{}{tfMap}
+}
+
+// suppressSnapStartApplyOnNone suppresses the difference between an explicit
+// apply_on = "None" configuration and no snap_start configuration block in state.
+func suppressSnapStartApplyOnNone(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("snap_start.0.apply_on").(string) != lambda.SnapStartApplyOnNone {
+		return false
+	}
+
+	return old == "" || old == "0"
+}
+
+func snapStartEnabled(tfList []interface{}) bool {
+	if v := expandSnapStart(tfList); v != nil {
+		return aws.StringValue(v.ApplyOn) == lambda.SnapStartApplyOnPublishedVersions
+	}
+
+	return false
+}
+
 func flattenEnvironment(apiObject *lambda.EnvironmentResponse) []interface{} {
 	if apiObject == nil {
 		return nil
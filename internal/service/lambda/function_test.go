@@ -1245,6 +1245,104 @@ func TestAccLambdaFunction_tracing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_snapStart(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionSnapStartConfig(rName, lambda.SnapStartApplyOnPublishedVersions, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckFunctionSnapStartApplyOn(&conf, lambda.SnapStartApplyOnPublishedVersions),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.apply_on", lambda.SnapStartApplyOnPublishedVersions),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.optimization_status", lambda.SnapStartOptimizationStatusOff),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+			{
+				Config: testAccFunctionSnapStartConfig(rName, lambda.SnapStartApplyOnNone, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckFunctionSnapStartApplyOn(&conf, lambda.SnapStartApplyOnNone),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "0"),
+				),
+			},
+			{
+				Config: testAccFunctionSnapStartConfig(rName, lambda.SnapStartApplyOnPublishedVersions, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckFunctionSnapStartApplyOn(&conf, lambda.SnapStartApplyOnPublishedVersions),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.apply_on", lambda.SnapStartApplyOnPublishedVersions),
+				),
+			},
+			{
+				Config: testAccFunctionSnapStartRemovedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckFunctionSnapStartApplyOn(&conf, lambda.SnapStartApplyOnNone),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_snapStartPublish(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionSnapStartConfig(rName, lambda.SnapStartApplyOnPublishedVersions, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckFunctionSnapStartApplyOn(&conf, lambda.SnapStartApplyOnPublishedVersions),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.apply_on", lambda.SnapStartApplyOnPublishedVersions),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccFunctionSnapStartConfig(rName, lambda.SnapStartApplyOnNone, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckFunctionSnapStartApplyOn(&conf, lambda.SnapStartApplyOnNone),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				Config: testAccFunctionSnapStartConfig(rName, lambda.SnapStartApplyOnPublishedVersions, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckFunctionSnapStartApplyOn(&conf, lambda.SnapStartApplyOnPublishedVersions),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.apply_on", lambda.SnapStartApplyOnPublishedVersions),
+					resource.TestCheckResourceAttr(resourceName, "version", "3"),
+				),
+			},
+		},
+	})
+}
+
 // This test is to verify the existing behavior in the Lambda API where the KMS Key ARN
 // is not returned if environment variables are not in use. If the API begins saving this
 // value and the kms_key_arn check begins failing, the documentation should be updated.
@@ -3583,3 +3681,50 @@ func testAccPreCheckSignerSigningProfile(t *testing.T, platformID string) {
 		t.Skipf("skipping acceptance testing: Signing Platform (%s) not found", platformID)
 	}
 }
+
+func testAccCheckFunctionSnapStartApplyOn(function *lambda.GetFunctionOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var actual string
+
+		if v := function.Configuration.SnapStart; v != nil {
+			actual = aws.StringValue(v.ApplyOn)
+		}
+
+		if actual != expected {
+			return fmt.Errorf("expected SnapStart ApplyOn %q, got %q", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+// test-fixtures/lambda_java.zip contains a minimal example.Handler class so
+// that published versions can complete SnapStart initialization.
+func testAccFunctionSnapStartConfig(rName, applyOn string, publish bool) string {
+	return fmt.Sprintf(acctest.ConfigLambdaBase(rName, rName, rName)+`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambda_java.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "example.Handler::handleRequest"
+  runtime       = "java11"
+  publish       = %[3]t
+
+  snap_start {
+    apply_on = %[2]q
+  }
+}
+`, rName, applyOn, publish)
+}
+
+func testAccFunctionSnapStartRemovedConfig(rName string) string {
+	return fmt.Sprintf(acctest.ConfigLambdaBase(rName, rName, rName)+`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambda_java.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "example.Handler::handleRequest"
+  runtime       = "java11"
+}
+`, rName)
+}
@@ -153,7 +153,6 @@ func ResourceBroker() *schema.Resource {
 			"ldap_server_metadata": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -339,6 +338,28 @@ func ResourceBroker() *schema.Resource {
 					}
 				}
 
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				engineType := diff.Get("engine_type").(string)
+
+				switch deploymentMode := diff.Get("deployment_mode").(string); {
+				case strings.EqualFold(deploymentMode, mq.DeploymentModeClusterMultiAz) && !strings.EqualFold(engineType, mq.EngineTypeRabbitmq):
+					return fmt.Errorf("deployment_mode: %s is only supported when engine is %s", mq.DeploymentModeClusterMultiAz, mq.EngineTypeRabbitmq)
+				case strings.EqualFold(deploymentMode, mq.DeploymentModeActiveStandbyMultiAz) && !strings.EqualFold(engineType, mq.EngineTypeActivemq):
+					return fmt.Errorf("deployment_mode: %s is only supported when engine is %s", mq.DeploymentModeActiveStandbyMultiAz, mq.EngineTypeActivemq)
+				}
+
+				if strings.EqualFold(engineType, mq.EngineTypeRabbitmq) {
+					if strings.EqualFold(diff.Get("authentication_strategy").(string), mq.AuthenticationStrategyLdap) {
+						return errors.New("authentication_strategy: ldap can not be configured when engine is RabbitMQ")
+					}
+
+					if v, ok := diff.GetOk("ldap_server_metadata"); ok && len(v.([]interface{})) > 0 {
+						return errors.New("ldap_server_metadata: Can not be configured when engine is RabbitMQ")
+					}
+				}
+
 				return nil
 			},
 		),
@@ -529,6 +550,26 @@ func resourceBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		requiresReboot = true
 	}
 
+	if d.HasChanges("authentication_strategy", "ldap_server_metadata") {
+		input := &mq.UpdateBrokerRequest{
+			BrokerId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("authentication_strategy"); ok {
+			input.AuthenticationStrategy = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("ldap_server_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.LdapServerMetadata = expandMQLDAPServerMetadata(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateBroker(input)
+		if err != nil {
+			return fmt.Errorf("error updating MQ Broker (%s) LDAP configuration: %w", d.Id(), err)
+		}
+		requiresReboot = true
+	}
+
 	if d.HasChange("user") {
 		o, n := d.GetChange("user")
 		var err error
@@ -1132,6 +1132,46 @@ func TestAccMQBroker_ldap(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.user_search_subtree", "true"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "user", "ldap_server_metadata.0.service_account_password"},
+			},
+			{
+				Config: testAccMqBrokerConfig_ldap(rName, "otherusername"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "ldap"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.hosts.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.service_account_username", "otherusername"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_deploymentModeValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(mq.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, mq.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBrokerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMqBrokerConfig_deploymentMode(rName, "ActiveMQ", "5.15.0", "CLUSTER_MULTI_AZ"),
+				ExpectError: regexp.MustCompile(`CLUSTER_MULTI_AZ is only supported when engine is RabbitMQ`),
+			},
+			{
+				Config:      testAccMqBrokerConfig_deploymentMode(rName, "RabbitMQ", "3.8.6", "ACTIVE_STANDBY_MULTI_AZ"),
+				ExpectError: regexp.MustCompile(`ACTIVE_STANDBY_MULTI_AZ is only supported when engine is ActiveMQ`),
+			},
 		},
 	})
 }
@@ -1861,3 +1901,25 @@ resource "aws_mq_broker" "test" {
 }
 `, rName, ldapUsername)
 }
+
+func testAccMqBrokerConfig_deploymentMode(rName, engineType, engineVersion, deploymentMode string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name        = %[1]q
+  deployment_mode    = %[4]q
+  engine_type        = %[2]q
+  engine_version     = %[3]q
+  host_instance_type = "mq.m5.large"
+  security_groups    = [aws_security_group.test.id]
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, engineType, engineVersion, deploymentMode)
+}
@@ -0,0 +1,14 @@
+package organizations
+
+import (
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// Policy types not currently provided by the AWS Go SDK.
+const (
+	policyTypeResourceControlPolicy = "RESOURCE_CONTROL_POLICY"
+)
+
+func policyType_Values() []string {
+	return append(organizations.PolicyType_Values(), policyTypeResourceControlPolicy)
+}
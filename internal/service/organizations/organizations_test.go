@@ -37,14 +37,16 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"disappears":             testAccPolicy_disappears,
 			"Type_AI_OPT_OUT":        testAccPolicy_type_AI_OPT_OUT,
 			"Type_Backup":            testAccPolicy_type_Backup,
+			"Type_RCP":               testAccPolicy_type_RCP,
 			"Type_SCP":               testAccPolicy_type_SCP,
 			"Type_Tag":               testAccPolicy_type_Tag,
 			"ImportAwsManagedPolicy": testAccPolicy_ImportAwsManagedPolicy,
 		},
 		"PolicyAttachment": {
-			"Account":            testAccPolicyAttachment_Account,
-			"OrganizationalUnit": testAccPolicyAttachment_OrganizationalUnit,
-			"Root":               testAccPolicyAttachment_Root,
+			"Account":               testAccPolicyAttachment_Account,
+			"OrganizationalUnit":    testAccPolicyAttachment_OrganizationalUnit,
+			"ResourceControlPolicy": testAccPolicyAttachment_ResourceControlPolicy,
+			"Root":                  testAccPolicyAttachment_Root,
 		},
 		"DelegatedAdministrator": {
 			"basic":      testAccDelegatedAdministrator_basic,
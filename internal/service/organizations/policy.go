@@ -53,7 +53,7 @@ func ResourcePolicy() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Default:      organizations.PolicyTypeServiceControlPolicy,
-				ValidateFunc: validation.StringInSlice(organizations.PolicyType_Values(), false),
+				ValidateFunc: validation.StringInSlice(policyType_Values(), false),
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -86,6 +86,36 @@ func testAccPolicyAttachment_OrganizationalUnit(t *testing.T) {
 	})
 }
 
+func testAccPolicyAttachment_ResourceControlPolicy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy_attachment.test"
+	policyIdResourceName := "aws_organizations_policy.test"
+	targetIdResourceName := "aws_organizations_organizational_unit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAttachmentConfig_ResourceControlPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(policyIdResourceName, "type", "RESOURCE_CONTROL_POLICY"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", policyIdResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", targetIdResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccPolicyAttachment_Root(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_organizations_policy_attachment.test"
@@ -266,6 +296,43 @@ resource "aws_organizations_policy_attachment" "test" {
 `, rName)
 }
 
+func testAccPolicyAttachmentConfig_ResourceControlPolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["RESOURCE_CONTROL_POLICY"]
+}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  content = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Deny",
+    "Principal": "*",
+    "Action": "sqs:*",
+    "Resource": "*"
+  }
+}
+EOF
+
+  name = %[1]q
+  type = "RESOURCE_CONTROL_POLICY"
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = aws_organizations_organizational_unit.test.id
+}
+`, rName)
+}
+
 func testAccPolicyAttachmentConfig_Root(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {
@@ -0,0 +1,34 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindPipeByName(conn *pipes.Pipes, name string) (*pipes.DescribePipeOutput, error) {
+	input := &pipes.DescribePipeInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribePipe(input)
+
+	if tfawserr.ErrCodeEquals(err, pipes.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,544 @@
+package pipes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePipe() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePipeCreate,
+		Read:   resourcePipeRead,
+		Update: resourcePipeUpdate,
+		Delete: resourcePipeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"desired_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      pipes.RequestedPipeStateRunning,
+				ValidateFunc: validation.StringInSlice(pipes.RequestedPipeState_Values(), false),
+			},
+			"enrichment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"enrichment_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header_parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"path_parameter_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query_string_parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"input_template": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 8192),
+						},
+					},
+				},
+			},
+			"log_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs_log_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"firehose_log_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"include_execution_data": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(pipes.IncludeExecutionDataOption_Values(), false),
+							},
+						},
+						"level": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(pipes.LogLevel_Values(), false),
+						},
+						"s3_log_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"output_format": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(pipes.S3OutputFormat_Values(), false),
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64),
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64-resource.UniqueIDSuffixLength),
+				ConflictsWith: []string{"name"},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourcePipeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PipesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &pipes.CreatePipeInput{
+		DesiredState: aws.String(d.Get("desired_state").(string)),
+		Name:         aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		Source:       aws.String(d.Get("source").(string)),
+		Target:       aws.String(d.Get("target").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment"); ok {
+		input.Enrichment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogConfiguration = expandPipeLogConfigurationParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Pipes Pipe: %s", input)
+	_, err := conn.CreatePipe(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EventBridge Pipes Pipe (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitPipeCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EventBridge Pipes Pipe (%s) create: %w", d.Id(), err)
+	}
+
+	return resourcePipeRead(d, meta)
+}
+
+func resourcePipeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PipesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPipeByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Pipes Pipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Pipes Pipe (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("desired_state", output.DesiredState)
+	d.Set("enrichment", output.Enrichment)
+	if err := d.Set("enrichment_parameters", flattenPipeEnrichmentParameters(output.EnrichmentParameters)); err != nil {
+		return fmt.Errorf("error setting enrichment_parameters: %w", err)
+	}
+	if err := d.Set("log_configuration", flattenPipeLogConfiguration(output.LogConfiguration)); err != nil {
+		return fmt.Errorf("error setting log_configuration: %w", err)
+	}
+	d.Set("name", output.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("role_arn", output.RoleArn)
+	d.Set("source", output.Source)
+	d.Set("target", output.Target)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePipeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PipesConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &pipes.UpdatePipeInput{
+			Description:  aws.String(d.Get("description").(string)),
+			DesiredState: aws.String(d.Get("desired_state").(string)),
+			// An empty string removes the enrichment.
+			Enrichment: aws.String(d.Get("enrichment").(string)),
+			Name:       aws.String(d.Id()),
+			RoleArn:    aws.String(d.Get("role_arn").(string)),
+			Target:     aws.String(d.Get("target").(string)),
+		}
+
+		if d.HasChange("enrichment_parameters") {
+			if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.EnrichmentParameters = &pipes.PipeEnrichmentParameters{}
+			}
+		}
+
+		if d.HasChange("log_configuration") {
+			if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LogConfiguration = expandPipeLogConfigurationParameters(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// Omitting the log configuration leaves it unchanged, so logging is turned off instead.
+				input.LogConfiguration = &pipes.PipeLogConfigurationParameters{
+					Level: aws.String(pipes.LogLevelOff),
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Updating EventBridge Pipes Pipe: %s", input)
+		_, err := conn.UpdatePipe(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EventBridge Pipes Pipe (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitPipeUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EventBridge Pipes Pipe (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating EventBridge Pipes Pipe (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourcePipeRead(d, meta)
+}
+
+func resourcePipeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PipesConn
+
+	log.Printf("[DEBUG] Deleting EventBridge Pipes Pipe: %s", d.Id())
+	_, err := conn.DeletePipe(&pipes.DeletePipeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pipes.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EventBridge Pipes Pipe (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitPipeDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EventBridge Pipes Pipe (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPipeEnrichmentParameters(tfMap map[string]interface{}) *pipes.PipeEnrichmentParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeEnrichmentParameters{}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpParameters = expandPipeEnrichmentHTTPParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeEnrichmentHTTPParameters(tfMap map[string]interface{}) *pipes.PipeEnrichmentHttpParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeEnrichmentHttpParameters{}
+
+	if v, ok := tfMap["header_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.HeaderParameters = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["path_parameter_values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PathParameterValues = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["query_string_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.QueryStringParameters = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeLogConfigurationParameters(tfMap map[string]interface{}) *pipes.PipeLogConfigurationParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeLogConfigurationParameters{
+		Level: aws.String(tfMap["level"].(string)),
+	}
+
+	if v, ok := tfMap["cloudwatch_logs_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudwatchLogsLogDestination = &pipes.CloudwatchLogsLogDestinationParameters{
+			LogGroupArn: aws.String(v[0].(map[string]interface{})["log_group_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["firehose_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FirehoseLogDestination = &pipes.FirehoseLogDestinationParameters{
+			DeliveryStreamArn: aws.String(v[0].(map[string]interface{})["delivery_stream_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["include_execution_data"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.IncludeExecutionData = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["s3_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3LogDestination = expandS3LogDestinationParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3LogDestinationParameters(tfMap map[string]interface{}) *pipes.S3LogDestinationParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.S3LogDestinationParameters{
+		BucketName:  aws.String(tfMap["bucket_name"].(string)),
+		BucketOwner: aws.String(tfMap["bucket_owner"].(string)),
+	}
+
+	if v, ok := tfMap["output_format"].(string); ok && v != "" {
+		apiObject.OutputFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPipeEnrichmentParameters(apiObject *pipes.PipeEnrichmentParameters) []interface{} {
+	if apiObject == nil || (apiObject.HttpParameters == nil && apiObject.InputTemplate == nil) {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"input_template": aws.StringValue(apiObject.InputTemplate),
+	}
+
+	if v := apiObject.HttpParameters; v != nil {
+		tfMap["http_parameters"] = []interface{}{map[string]interface{}{
+			"header_parameters":       aws.StringValueMap(v.HeaderParameters),
+			"path_parameter_values":   aws.StringValueSlice(v.PathParameterValues),
+			"query_string_parameters": aws.StringValueMap(v.QueryStringParameters),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPipeLogConfiguration(apiObject *pipes.PipeLogConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	// A pipe whose log configuration was removed reports logging as turned off with no destinations.
+	if aws.StringValue(apiObject.Level) == pipes.LogLevelOff && apiObject.CloudwatchLogsLogDestination == nil && apiObject.FirehoseLogDestination == nil && apiObject.S3LogDestination == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"include_execution_data": aws.StringValueSlice(apiObject.IncludeExecutionData),
+		"level":                  aws.StringValue(apiObject.Level),
+	}
+
+	if v := apiObject.CloudwatchLogsLogDestination; v != nil {
+		tfMap["cloudwatch_logs_log_destination"] = []interface{}{map[string]interface{}{
+			"log_group_arn": aws.StringValue(v.LogGroupArn),
+		}}
+	}
+
+	if v := apiObject.FirehoseLogDestination; v != nil {
+		tfMap["firehose_log_destination"] = []interface{}{map[string]interface{}{
+			"delivery_stream_arn": aws.StringValue(v.DeliveryStreamArn),
+		}}
+	}
+
+	if v := apiObject.S3LogDestination; v != nil {
+		tfMap["s3_log_destination"] = []interface{}{map[string]interface{}{
+			"bucket_name":   aws.StringValue(v.BucketName),
+			"bucket_owner":  aws.StringValue(v.BucketOwner),
+			"output_format": aws.StringValue(v.OutputFormat),
+			"prefix":        aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,497 @@
+package pipes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pipes"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpipes "github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPipesPipe_basic(t *testing.T) {
+	var v pipes.DescribePipeOutput
+	resourceName := "aws_pipes_pipe.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "pipes", regexp.MustCompile(`pipe/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment", ""),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "aws_sqs_queue.source", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target", "aws_sqs_queue.target", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_disappears(t *testing.T) {
+	var v pipes.DescribePipeOutput
+	resourceName := "aws_pipes_pipe.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpipes.ResourcePipe(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_enrichment(t *testing.T) {
+	var v pipes.DescribePipeOutput
+	resourceName := "aws_pipes_pipe.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeEnrichmentConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "enrichment", "aws_cloudwatch_event_api_destination.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.header_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.header_parameters.X-Test", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.path_parameter_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.path_parameter_values.0", "p1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.query_string_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.query_string_parameters.q", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.input_template", `{"body": <$.body>}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeEnrichmentConfig(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.header_parameters.X-Test", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.query_string_parameters.q", "value2"),
+				),
+			},
+			{
+				Config: testAccPipeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enrichment", ""),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_logConfiguration(t *testing.T) {
+	var v pipes.DescribePipeOutput
+	resourceName := "aws_pipes_pipe.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeLogConfigurationCloudWatchLogsConfig(rName, "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.0.log_group_arn", "aws_cloudwatch_log_group.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.firehose_log_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.include_execution_data.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "log_configuration.0.include_execution_data.*", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.s3_log_destination.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeLogConfigurationS3Config(rName, "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.include_execution_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.s3_log_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_configuration.0.s3_log_destination.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					acctest.CheckResourceAttrAccountID(resourceName, "log_configuration.0.s3_log_destination.0.bucket_owner"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.s3_log_destination.0.output_format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.s3_log_destination.0.prefix", "logs/"),
+				),
+			},
+			{
+				Config: testAccPipeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_tags(t *testing.T) {
+	var v pipes.DescribePipeOutput
+	resourceName := "aws_pipes_pipe.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPipeTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPipeExists(n string, v *pipes.DescribePipeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Pipes Pipe ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PipesConn
+
+		output, err := tfpipes.FindPipeByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPipeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PipesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pipes_pipe" {
+			continue
+		}
+
+		_, err := tfpipes.FindPipeByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EventBridge Pipes Pipe %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPipeBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "source" {
+  name = "%[1]s-source"
+}
+
+resource "aws_sqs_queue" "target" {
+  name = "%[1]s-target"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "pipes.${data.aws_partition.current.dns_suffix}" }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = ["sqs:DeleteMessage", "sqs:GetQueueAttributes", "sqs:ReceiveMessage"]
+        Effect   = "Allow"
+        Resource = aws_sqs_queue.source.arn
+      },
+      {
+        Action   = "sqs:SendMessage"
+        Effect   = "Allow"
+        Resource = aws_sqs_queue.target.arn
+      },
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccPipeConfig(rName string) string {
+	return acctest.ConfigCompose(testAccPipeBaseConfig(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+}
+`, rName))
+}
+
+func testAccPipeEnrichmentConfig(rName, value string) string {
+	return acctest.ConfigCompose(testAccPipeBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "API_KEY"
+
+  auth_parameters {
+    api_key {
+      key   = "testKey"
+      value = "testValue"
+    }
+  }
+}
+
+resource "aws_cloudwatch_event_api_destination" "test" {
+  name                = %[1]q
+  invocation_endpoint = "https://example.com/*"
+  http_method         = "POST"
+  connection_arn      = aws_cloudwatch_event_connection.test.arn
+}
+
+resource "aws_iam_role_policy" "enrichment" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "events:InvokeApiDestination"
+      Effect   = "Allow"
+      Resource = aws_cloudwatch_event_api_destination.test.arn
+    }]
+  })
+}
+
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.test, aws_iam_role_policy.enrichment]
+
+  name       = %[1]q
+  role_arn   = aws_iam_role.test.arn
+  source     = aws_sqs_queue.source.arn
+  target     = aws_sqs_queue.target.arn
+  enrichment = aws_cloudwatch_event_api_destination.test.arn
+
+  enrichment_parameters {
+    input_template = "{\"body\": <$.body>}"
+
+    http_parameters {
+      header_parameters = {
+        "X-Test" = %[2]q
+      }
+
+      path_parameter_values = ["p1"]
+
+      query_string_parameters = {
+        "q" = %[2]q
+      }
+    }
+  }
+}
+`, rName, value))
+}
+
+func testAccPipeLogConfigurationCloudWatchLogsConfig(rName, level string) string {
+	return acctest.ConfigCompose(testAccPipeBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  log_configuration {
+    include_execution_data = ["ALL"]
+    level                  = %[2]q
+
+    cloudwatch_logs_log_destination {
+      log_group_arn = aws_cloudwatch_log_group.test.arn
+    }
+  }
+}
+`, rName, level))
+}
+
+func testAccPipeLogConfigurationS3Config(rName, level string) string {
+	return acctest.ConfigCompose(testAccPipeBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  log_configuration {
+    level = %[2]q
+
+    s3_log_destination {
+      bucket_name   = aws_s3_bucket.test.bucket
+      bucket_owner  = data.aws_caller_identity.current.account_id
+      output_format = "json"
+      prefix        = "logs/"
+    }
+  }
+}
+`, rName, level))
+}
+
+func testAccPipeTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipeBaseConfig(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPipeTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipeBaseConfig(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,71 @@
+package pipes
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitPipeCreated(conn *pipes.Pipes, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{pipes.PipeStateCreating},
+		Target:                    []string{pipes.PipeStateRunning, pipes.PipeStateStopped},
+		Refresh:                   statusPipe(conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipeUpdated(conn *pipes.Pipes, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{pipes.PipeStateUpdating, pipes.PipeStateStarting, pipes.PipeStateStopping},
+		Target:                    []string{pipes.PipeStateRunning, pipes.PipeStateStopped},
+		Refresh:                   statusPipe(conn, name),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipeDeleted(conn *pipes.Pipes, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pipes.PipeStateDeleting},
+		Target:  []string{},
+		Refresh: statusPipe(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
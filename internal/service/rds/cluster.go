@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,10 +33,10 @@ const (
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterCreate,
-		Read:   resourceClusterRead,
-		Update: resourceClusterUpdate,
-		Delete: resourceClusterDelete,
+		Create:        resourceClusterCreate,
+		Read:          resourceClusterRead,
+		UpdateContext: resourceClusterUpdate,
+		Delete:        resourceClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceClusterImport,
 		},
@@ -238,6 +241,14 @@ func ResourceCluster() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"storage_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice(ClusterStorageType_Values(), false),
+				DiffSuppressFunc: suppressClusterStorageTypeAuroraDefault,
+			},
+
 			"restore_to_point_in_time": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -484,6 +495,12 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
+// suppressClusterStorageTypeAuroraDefault suppresses the difference between the default aurora
+// storage type and an empty value, as the API does not always return the default storage type.
+func suppressClusterStorageTypeAuroraDefault(k, old, new string, d *schema.ResourceData) bool {
+	return (old == "" && new == ClusterStorageTypeAurora) || (old == ClusterStorageTypeAurora && new == "")
+}
+
 func resourceClusterImport(
 	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
@@ -579,6 +596,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			opts.Port = aws.Int64(int64(attr.(int)))
 		}
 
+		if attr, ok := d.GetOk("storage_type"); ok {
+			opts.StorageType = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("preferred_backup_window"); ok {
 			modifyDbClusterInput.PreferredBackupWindow = aws.String(attr.(string))
 			requiresModifyDbCluster = true
@@ -693,6 +714,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.StorageEncrypted = aws.Bool(attr.(bool))
 		}
 
+		if attr, ok := d.GetOk("storage_type"); ok {
+			createOpts.StorageType = aws.String(attr.(string))
+		}
+
 		log.Printf("[DEBUG] RDS Cluster restore options: %s", createOpts)
 		// Retry for IAM/S3 eventual consistency
 		var resp *rds.RestoreDBClusterFromS3Output
@@ -743,6 +768,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.UseLatestRestorableTime = aws.Bool(v)
 		}
 
+		if attr, ok := d.GetOk("storage_type"); ok {
+			createOpts.StorageType = aws.String(attr.(string))
+		}
+
 		if createOpts.RestoreToTime == nil && createOpts.UseLatestRestorableTime == nil {
 			return fmt.Errorf(`provider.aws: aws_rds_cluster: %s: Either "restore_to_time" or "use_latest_restorable_time" must be set`, d.Get("database_name").(string))
 		}
@@ -926,6 +955,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.StorageEncrypted = aws.Bool(attr.(bool))
 		}
 
+		if attr, ok := d.GetOk("storage_type"); ok {
+			createOpts.StorageType = aws.String(attr.(string))
+		}
+
 		log.Printf("[DEBUG] RDS Cluster create options: %s", createOpts)
 		var resp *rds.CreateDBClusterOutput
 		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
@@ -1104,6 +1137,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("storage_encrypted", dbc.StorageEncrypted)
+	d.Set("storage_type", dbc.StorageType)
 	d.Set("enable_http_endpoint", dbc.HttpEndpointEnabled)
 
 	var vpcg []string
@@ -1149,7 +1183,8 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn
 	requestUpdate := false
 
@@ -1261,6 +1296,28 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChange("storage_type") {
+		storageType := d.Get("storage_type").(string)
+
+		// Switching to I/O-Optimized is only allowed once every 30 days.
+		// Leave enforcement to the API and only warn ahead of the request.
+		if storageType == ClusterStorageTypeAuroraIopt1 {
+			if dbc, err := FindDBClusterByID(conn, d.Id()); err == nil {
+				if v := dbc.IOOptimizedNextAllowedModificationTime; v != nil && time.Now().Before(aws.TimeValue(v)) {
+					diags = append(diags, diag.Diagnostic{
+						Severity:      diag.Warning,
+						Summary:       fmt.Sprintf("RDS Cluster (%s) storage_type may not be changed to %s until %s", d.Id(), storageType, aws.TimeValue(v).Format(time.RFC3339)),
+						Detail:        "AWS only allows switching a cluster to I/O-Optimized storage once every 30 days. The modification is still requested and may be rejected.",
+						AttributePath: cty.GetAttrPath("storage_type"),
+					})
+				}
+			}
+		}
+
+		req.StorageType = aws.String(storageType)
+		requestUpdate = true
+	}
+
 	if requestUpdate {
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			_, err := conn.ModifyDBCluster(req)
@@ -1284,13 +1341,13 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			_, err = conn.ModifyDBCluster(req)
 		}
 		if err != nil {
-			return fmt.Errorf("Failed to modify RDS Cluster (%s): %s", d.Id(), err)
+			return append(diags, diag.FromErr(fmt.Errorf("Failed to modify RDS Cluster (%s): %s", d.Id(), err))...)
 		}
 
 		log.Printf("[INFO] Waiting for RDS Cluster (%s) to be available", d.Id())
 		err = waitForRDSClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err)
+			return append(diags, diag.FromErr(fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err))...)
 		}
 	}
 
@@ -1300,11 +1357,11 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		n := nRaw.(string)
 
 		if o == "" {
-			return errors.New("Existing RDS Clusters cannot be added to an existing RDS Global Cluster")
+			return append(diags, diag.FromErr(errors.New("Existing RDS Clusters cannot be added to an existing RDS Global Cluster"))...)
 		}
 
 		if n != "" {
-			return errors.New("Existing RDS Clusters cannot be migrated between existing RDS Global Clusters")
+			return append(diags, diag.FromErr(errors.New("Existing RDS Clusters cannot be migrated between existing RDS Global Clusters"))...)
 		}
 
 		input := &rds.RemoveFromGlobalClusterInput{
@@ -1316,7 +1373,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, err := conn.RemoveFromGlobalCluster(input)
 
 		if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeGlobalClusterNotFoundFault) && !tfawserr.ErrMessageContains(err, "InvalidParameterValue", "is not found in global cluster") {
-			return fmt.Errorf("error removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err)
+			return append(diags, diag.FromErr(fmt.Errorf("error removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err))...)
 		}
 	}
 
@@ -1337,14 +1394,14 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		for _, role := range enableRoles.List() {
 			err := setIAMRoleToCluster(d.Id(), role.(string), conn)
 			if err != nil {
-				return err
+				return append(diags, diag.FromErr(err)...)
 			}
 		}
 
 		for _, role := range removeRoles.List() {
 			err := removeIAMRoleFromCluster(d.Id(), role.(string), conn)
 			if err != nil {
-				return err
+				return append(diags, diag.FromErr(err)...)
 			}
 		}
 	}
@@ -1353,11 +1410,15 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+			return append(diags, diag.FromErr(fmt.Errorf("error updating tags: %s", err))...)
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	if err := resourceClusterRead(d, meta); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
 }
 
 func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
@@ -845,6 +845,46 @@ func TestAccRDSCluster_deletionProtection(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_storageType(t *testing.T) {
+	var dbCluster1 rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_StorageType(rName, "aurora"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster1),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "aurora"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_StorageType(rName, "aurora-iopt1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster1),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "aurora-iopt1"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_StorageType(rName, "aurora"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster1),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "aurora"),
+				),
+			},
+			{
+				Config:      testAccClusterConfig_StorageType(rName, "aurora-iopt1"),
+				ExpectError: regexp.MustCompile(`Failed to modify RDS Cluster`),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_engineMode(t *testing.T) {
 	var dbCluster1, dbCluster2 rds.DBCluster
 
@@ -3052,6 +3092,20 @@ resource "aws_rds_cluster" "test" {
 `, rName, deletionProtection)
 }
 
+func testAccClusterConfig_StorageType(rName, storageType string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[1]q
+  engine              = "aurora-postgresql"
+  master_password     = "barbarbarbar"
+  master_username     = "foo"
+  skip_final_snapshot = true
+  storage_type        = %[2]q
+  apply_immediately   = true
+}
+`, rName, storageType)
+}
+
 func testAccClusterConfig_EngineMode(rName, engineMode string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -22,6 +22,18 @@ const (
 	InstanceStatusStorageOptimization           = "storage-optimization"
 )
 
+const (
+	ClusterStorageTypeAurora      = "aurora"
+	ClusterStorageTypeAuroraIopt1 = "aurora-iopt1"
+)
+
+func ClusterStorageType_Values() []string {
+	return []string{
+		ClusterStorageTypeAurora,
+		ClusterStorageTypeAuroraIopt1,
+	}
+}
+
 const (
 	EventSubscriptionStatusActive    = "active"
 	EventSubscriptionStatusCreating  = "creating"
@@ -85,3 +85,19 @@ func FindKeySigningKeyByResourceID(conn *route53.Route53, resourceID string) (*r
 
 	return FindKeySigningKey(conn, hostedZoneID, name)
 }
+
+func findHostedZoneNameByID(conn *route53.Route53, zoneID string) (string, error) {
+	output, err := conn.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(zoneID),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.HostedZone == nil {
+		return "", fmt.Errorf("empty result")
+	}
+
+	return aws.StringValue(output.HostedZone.Name), nil
+}
@@ -0,0 +1,771 @@
+package route53
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	// A change batch can contain at most 1,000 ResourceRecord elements, with UPSERT counting twice.
+	recordsExclusiveMaxResourceRecordsPerBatch = 1000
+)
+
+func ResourceRecordsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRecordsExclusivePut,
+		Read:   resourceRecordsExclusiveRead,
+		Update: resourceRecordsExclusivePut,
+		Delete: resourceRecordsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceRecordsExclusiveCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"exclusion": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+					},
+				},
+			},
+			"resource_record_set": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"zone_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetFailover_Values(), false),
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 4000),
+							},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+				Set: recordsExclusiveResourceRecordSetHash,
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceRecordsExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+
+	zoneName, err := findHostedZoneNameByID(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	exclusions := expandRecordsExclusiveExclusions(d.Get("exclusion").([]interface{}), zoneName)
+
+	current, err := findRecordsExclusiveResourceRecordSets(conn, zoneID, zoneName, exclusions)
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Hosted Zone (%s) record sets: %w", zoneID, err)
+	}
+
+	desired, err := expandRecordsExclusiveResourceRecordSets(d.Get("resource_record_set").(*schema.Set).List(), zoneName)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range desired {
+		if recordsExclusiveIsApexDefault(v, zoneName) || recordsExclusiveIsExcluded(v, exclusions) {
+			return fmt.Errorf("resource_record_set (%s %s) is a zone apex SOA or NS record or matches an exclusion and cannot be managed", aws.StringValue(v.Name), aws.StringValue(v.Type))
+		}
+	}
+
+	changes := recordsExclusiveChanges(current, desired)
+
+	if d.IsNewResource() {
+		d.SetId(zoneID)
+	}
+
+	for _, batch := range recordsExclusiveChangeBatches(changes) {
+		for _, change := range batch {
+			if aws.StringValue(change.Action) == route53.ChangeActionDelete {
+				log.Printf("[WARN] Deleting Route 53 Hosted Zone (%s) record set not managed by Terraform: %s %s", zoneID, aws.StringValue(change.ResourceRecordSet.Name), aws.StringValue(change.ResourceRecordSet.Type))
+			}
+		}
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String("Managed by Terraform"),
+				Changes: batch,
+			},
+		}
+
+		outputRaw, err := ChangeRecordSet(conn, input)
+
+		if err != nil {
+			return fmt.Errorf("error changing Route 53 Hosted Zone (%s) record sets: %w", zoneID, err)
+		}
+
+		if output, ok := outputRaw.(*route53.ChangeResourceRecordSetsOutput); ok && output.ChangeInfo != nil {
+			if _, err := waitChangeInfoStatusInsync(conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id))); err != nil {
+				return fmt.Errorf("error waiting for Route 53 Hosted Zone (%s) record sets change: %w", zoneID, err)
+			}
+		}
+	}
+
+	return resourceRecordsExclusiveRead(d, meta)
+}
+
+func resourceRecordsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneName, err := findHostedZoneNameByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", d.Id(), err)
+	}
+
+	exclusions := expandRecordsExclusiveExclusions(d.Get("exclusion").([]interface{}), zoneName)
+
+	recordSets, err := findRecordsExclusiveResourceRecordSets(conn, d.Id(), zoneName, exclusions)
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Hosted Zone (%s) record sets: %w", d.Id(), err)
+	}
+
+	d.Set("zone_id", d.Id())
+
+	// Keep names as configured, e.g. relative to the zone, when they refer to the same record set.
+	names := make(map[string]string)
+	for _, tfMapRaw := range d.Get("resource_record_set").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			name := tfMap["name"].(string)
+			names[recordsExclusiveNormalizeName(ExpandRecordName(name, zoneName))] = name
+		}
+	}
+
+	if err := d.Set("resource_record_set", flattenRecordsExclusiveResourceRecordSets(recordSets, names)); err != nil {
+		return fmt.Errorf("error setting resource_record_set: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRecordsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Route 53 Hosted Zone (%s) record sets are left in place, removing from state only", d.Id())
+
+	return nil
+}
+
+func resourceRecordsExclusiveCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("resource_record_set") {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("resource_record_set").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if err := validateRecordsExclusiveRoutingPolicy(tfMap); err != nil {
+			return err
+		}
+
+		if err := validateRecordsExclusiveAlias(tfMap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateRecordsExclusiveRoutingPolicy returns an error unless a record set either has a set_identifier
+// and exactly one routing policy, or neither.
+func validateRecordsExclusiveRoutingPolicy(tfMap map[string]interface{}) error {
+	var policies []string
+
+	for _, k := range []string{"failover_routing_policy", "geolocation_routing_policy", "latency_routing_policy", "weighted_routing_policy"} {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 {
+			policies = append(policies, k)
+		}
+	}
+
+	if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+		policies = append(policies, "multivalue_answer_routing_policy")
+	}
+
+	setIdentifier, _ := tfMap["set_identifier"].(string)
+
+	switch {
+	case setIdentifier != "" && len(policies) == 0:
+		return fmt.Errorf("resource_record_set (%s %s): set_identifier requires a routing policy", tfMap["name"], tfMap["type"])
+	case setIdentifier == "" && len(policies) > 0:
+		return fmt.Errorf("resource_record_set (%s %s): %s requires set_identifier", tfMap["name"], tfMap["type"], policies[0])
+	case len(policies) > 1:
+		return fmt.Errorf("resource_record_set (%s %s): only one routing policy can be set, got %s", tfMap["name"], tfMap["type"], strings.Join(policies, ", "))
+	}
+
+	return nil
+}
+
+// validateRecordsExclusiveAlias returns an error if a record set has both an alias and ttl or records.
+func validateRecordsExclusiveAlias(tfMap map[string]interface{}) error {
+	if v, ok := tfMap["alias"].([]interface{}); !ok || len(v) == 0 {
+		return nil
+	}
+
+	ttl, _ := tfMap["ttl"].(int)
+	records, _ := tfMap["records"].(*schema.Set)
+
+	if ttl != 0 || (records != nil && records.Len() > 0) {
+		return fmt.Errorf("resource_record_set (%s %s): alias conflicts with ttl and records", tfMap["name"], tfMap["type"])
+	}
+
+	return nil
+}
+
+// findRecordsExclusiveResourceRecordSets returns all of the zone's record sets, except for the zone apex
+// SOA and NS records and any record sets that match an exclusion.
+func findRecordsExclusiveResourceRecordSets(conn *route53.Route53, zoneID, zoneName string, exclusions []recordsExclusiveExclusion) ([]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	var recordSets []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPages(input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, recordSet := range page.ResourceRecordSets {
+			if recordSet == nil {
+				continue
+			}
+
+			if recordsExclusiveIsApexDefault(recordSet, zoneName) || recordsExclusiveIsExcluded(recordSet, exclusions) {
+				continue
+			}
+
+			recordSets = append(recordSets, recordSet)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return recordSets, nil
+}
+
+type recordsExclusiveExclusion struct {
+	name       string
+	recordType string
+}
+
+func expandRecordsExclusiveExclusions(tfList []interface{}, zoneName string) []recordsExclusiveExclusion {
+	var exclusions []recordsExclusiveExclusion
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		exclusions = append(exclusions, recordsExclusiveExclusion{
+			name:       recordsExclusiveNormalizeName(ExpandRecordName(tfMap["name"].(string), zoneName)),
+			recordType: tfMap["type"].(string),
+		})
+	}
+
+	return exclusions
+}
+
+func recordsExclusiveNormalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(CleanRecordName(name)), ".")
+}
+
+func recordsExclusiveIsApexDefault(recordSet *route53.ResourceRecordSet, zoneName string) bool {
+	if recordsExclusiveNormalizeName(aws.StringValue(recordSet.Name)) != recordsExclusiveNormalizeName(zoneName) {
+		return false
+	}
+
+	recordType := aws.StringValue(recordSet.Type)
+
+	return recordType == route53.RRTypeNs || recordType == route53.RRTypeSoa
+}
+
+func recordsExclusiveIsExcluded(recordSet *route53.ResourceRecordSet, exclusions []recordsExclusiveExclusion) bool {
+	name := recordsExclusiveNormalizeName(aws.StringValue(recordSet.Name))
+
+	for _, exclusion := range exclusions {
+		if exclusion.name != name {
+			continue
+		}
+
+		if exclusion.recordType == "" || exclusion.recordType == aws.StringValue(recordSet.Type) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func recordsExclusiveKey(recordSet *route53.ResourceRecordSet) string {
+	return strings.Join([]string{
+		recordsExclusiveNormalizeName(aws.StringValue(recordSet.Name)),
+		aws.StringValue(recordSet.Type),
+		aws.StringValue(recordSet.SetIdentifier),
+	}, "_")
+}
+
+// recordsExclusiveChanges returns the changes that reconcile the current record sets with the desired ones.
+// Record sets that are no longer desired are deleted, before any desired record sets that are missing or
+// differ from the current ones are upserted.
+func recordsExclusiveChanges(current, desired []*route53.ResourceRecordSet) []*route53.Change {
+	currentByKey := make(map[string]*route53.ResourceRecordSet, len(current))
+	for _, v := range current {
+		currentByKey[recordsExclusiveKey(v)] = v
+	}
+
+	desiredByKey := make(map[string]*route53.ResourceRecordSet, len(desired))
+	for _, v := range desired {
+		desiredByKey[recordsExclusiveKey(v)] = v
+	}
+
+	var deletes, upserts []*route53.Change
+
+	for _, v := range current {
+		if _, ok := desiredByKey[recordsExclusiveKey(v)]; ok {
+			continue
+		}
+
+		deletes = append(deletes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: v,
+		})
+	}
+
+	for _, v := range desired {
+		if c, ok := currentByKey[recordsExclusiveKey(v)]; ok && recordsExclusiveResourceRecordSetHash(flattenRecordsExclusiveResourceRecordSet(c)) == recordsExclusiveResourceRecordSetHash(flattenRecordsExclusiveResourceRecordSet(v)) {
+			continue
+		}
+
+		upserts = append(upserts, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: v,
+		})
+	}
+
+	return append(deletes, upserts...)
+}
+
+// recordsExclusiveChangeBatches splits changes into batches that stay within the ChangeResourceRecordSets limits.
+func recordsExclusiveChangeBatches(changes []*route53.Change) [][]*route53.Change {
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	var size int
+
+	for _, change := range changes {
+		n := len(change.ResourceRecordSet.ResourceRecords)
+		if n == 0 {
+			n = 1
+		}
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			n *= 2
+		}
+
+		if len(batch) > 0 && size+n > recordsExclusiveMaxResourceRecordsPerBatch {
+			batches = append(batches, batch)
+			batch = nil
+			size = 0
+		}
+
+		batch = append(batch, change)
+		size += n
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func expandRecordsExclusiveResourceRecordSets(tfList []interface{}, zoneName string) ([]*route53.ResourceRecordSet, error) {
+	var apiObjects []*route53.ResourceRecordSet
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &route53.ResourceRecordSet{
+			Name: aws.String(ExpandRecordName(tfMap["name"].(string), zoneName)),
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if err := validateRecordsExclusiveRoutingPolicy(tfMap); err != nil {
+			return nil, err
+		}
+
+		if err := validateRecordsExclusiveAlias(tfMap); err != nil {
+			return nil, err
+		}
+
+		if v, ok := tfMap["set_identifier"].(string); ok && v != "" {
+			apiObject.SetIdentifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["health_check_id"].(string); ok && v != "" {
+			apiObject.HealthCheckId = aws.String(v)
+		}
+
+		if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Failover = aws.String(v[0].(map[string]interface{})["type"].(string))
+		}
+
+		if v, ok := tfMap["geolocation_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			geolocation := v[0].(map[string]interface{})
+			apiObject.GeoLocation = &route53.GeoLocation{}
+
+			if v := geolocation["continent"].(string); v != "" {
+				apiObject.GeoLocation.ContinentCode = aws.String(v)
+			}
+
+			if v := geolocation["country"].(string); v != "" {
+				apiObject.GeoLocation.CountryCode = aws.String(v)
+			}
+
+			if v := geolocation["subdivision"].(string); v != "" {
+				apiObject.GeoLocation.SubdivisionCode = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Region = aws.String(v[0].(map[string]interface{})["region"].(string))
+		}
+
+		if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+			apiObject.MultiValueAnswer = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Weight = aws.Int64(int64(v[0].(map[string]interface{})["weight"].(int)))
+		}
+
+		if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			alias := v[0].(map[string]interface{})
+
+			apiObject.AliasTarget = &route53.AliasTarget{
+				DNSName:              aws.String(alias["name"].(string)),
+				EvaluateTargetHealth: aws.Bool(alias["evaluate_target_health"].(bool)),
+				HostedZoneId:         aws.String(alias["zone_id"].(string)),
+			}
+		} else {
+			ttl, _ := tfMap["ttl"].(int)
+			records, _ := tfMap["records"].(*schema.Set)
+
+			if ttl == 0 || records == nil || records.Len() == 0 {
+				return nil, fmt.Errorf("resource_record_set (%s %s): ttl and records are required when alias is not set", aws.StringValue(apiObject.Name), aws.StringValue(apiObject.Type))
+			}
+
+			apiObject.TTL = aws.Int64(int64(ttl))
+			apiObject.ResourceRecords = expandResourceRecords(records.List(), aws.StringValue(apiObject.Type))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+// flattenRecordsExclusiveResourceRecordSets flattens the record sets, using the name from names,
+// keyed by normalized fully qualified name, if present.
+func flattenRecordsExclusiveResourceRecordSets(apiObjects []*route53.ResourceRecordSet, names map[string]string) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := flattenRecordsExclusiveResourceRecordSet(apiObject)
+
+		if v, ok := names[tfMap["name"].(string)]; ok {
+			tfMap["name"] = v
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenRecordsExclusiveResourceRecordSet(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	recordType := aws.StringValue(apiObject.Type)
+
+	tfMap := map[string]interface{}{
+		"health_check_id": aws.StringValue(apiObject.HealthCheckId),
+		"name":            recordsExclusiveNormalizeName(aws.StringValue(apiObject.Name)),
+		"set_identifier":  aws.StringValue(apiObject.SetIdentifier),
+		"ttl":             int(aws.Int64Value(apiObject.TTL)),
+		"type":            recordType,
+	}
+
+	if v := apiObject.Failover; v != nil {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		tfMap["geolocation_routing_policy"] = []interface{}{map[string]interface{}{
+			"continent":   aws.StringValue(v.ContinentCode),
+			"country":     aws.StringValue(v.CountryCode),
+			"subdivision": aws.StringValue(v.SubdivisionCode),
+		}}
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			"region": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.MultiValueAnswer; v != nil {
+		tfMap["multivalue_answer_routing_policy"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			"weight": int(aws.Int64Value(v)),
+		}}
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.ResourceRecords; len(v) > 0 {
+		tfMap["records"] = flex.FlattenStringSet(aws.StringSlice(FlattenResourceRecords(v, recordType)))
+	}
+
+	return tfMap
+}
+
+func recordsExclusiveResourceRecordSetHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", recordsExclusiveNormalizeName(m["name"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", m["type"].(string)))
+
+	if v, ok := m["set_identifier"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := m["ttl"].(int); ok {
+		buf.WriteString(fmt.Sprintf("%d-", v))
+	}
+
+	if v, ok := m["health_check_id"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := m["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("failover:%s-", v[0].(map[string]interface{})["type"].(string)))
+	}
+
+	if v, ok := m["geolocation_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		geolocation := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("geolocation:%s:%s:%s-", geolocation["continent"].(string), geolocation["country"].(string), geolocation["subdivision"].(string)))
+	}
+
+	if v, ok := m["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("latency:%s-", v[0].(map[string]interface{})["region"].(string)))
+	}
+
+	if v, ok := m["multivalue_answer_routing_policy"].(bool); ok && v {
+		buf.WriteString("multivalue-")
+	}
+
+	if v, ok := m["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("weighted:%d-", v[0].(map[string]interface{})["weight"].(int)))
+	}
+
+	if v, ok := m["records"].(*schema.Set); ok {
+		records := make([]string, 0, v.Len())
+		for _, r := range v.List() {
+			records = append(records, r.(string))
+		}
+		sort.Strings(records)
+		buf.WriteString(fmt.Sprintf("%s-", strings.Join(records, ",")))
+	}
+
+	if v, ok := m["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("%s-", NormalizeAliasName(alias["name"].(string))))
+		buf.WriteString(fmt.Sprintf("%s-", alias["zone_id"].(string)))
+		buf.WriteString(fmt.Sprintf("%t-", alias["evaluate_target_health"].(bool)))
+	}
+
+	return create.StringHashcode(buf.String())
+}
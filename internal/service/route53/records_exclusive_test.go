@@ -0,0 +1,322 @@
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestAccRoute53RecordsExclusive_basic(t *testing.T) {
+	var zone route53.GetHostedZoneOutput
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig(zoneName, "127.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ZoneExists("aws_route53_zone.test", &zone),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":      fmt.Sprintf("www.%s", zoneName),
+						"type":      "A",
+						"ttl":       "300",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":      fmt.Sprintf("mail.%s", zoneName),
+						"type":      "TXT",
+						"ttl":       "60",
+						"records.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclusion"},
+			},
+			{
+				PreConfig: func() {
+					testAccRecordsExclusiveCreateUnmanagedRecord(t, &zone, fmt.Sprintf("unmanaged.%s", zoneName))
+					testAccRecordsExclusiveCreateUnmanagedRecord(t, &zone, fmt.Sprintf("excluded.%s", zoneName))
+					testAccRecordsExclusiveCreateUnmanagedRecord(t, &zone, fmt.Sprintf("_acme-challenge.%s", zoneName))
+				},
+				Config: testAccRecordsExclusiveConfig(zoneName, "127.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_record_set.*.records.*", "127.0.0.2"),
+					testAccCheckRecordsExclusiveRecordCount(&zone, fmt.Sprintf("unmanaged.%s.", zoneName), 0),
+					testAccCheckRecordsExclusiveRecordCount(&zone, fmt.Sprintf("excluded.%s.", zoneName), 1),
+					testAccCheckRecordsExclusiveRecordCount(&zone, fmt.Sprintf("_acme-challenge.%s.", zoneName), 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_weightedRoutingPolicy(t *testing.T) {
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveWeightedRoutingPolicyConfig(zoneName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":                             "www",
+						"set_identifier":                   "primary",
+						"weighted_routing_policy.#":        "1",
+						"weighted_routing_policy.0.weight": "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":                             "www",
+						"set_identifier":                   "secondary",
+						"weighted_routing_policy.#":        "1",
+						"weighted_routing_policy.0.weight": "10",
+					}),
+				),
+			},
+			{
+				Config: testAccRecordsExclusiveWeightedRoutingPolicyConfig(zoneName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"set_identifier":                   "primary",
+						"weighted_routing_policy.0.weight": "50",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_Validation_setIdentifierWithoutRoutingPolicy(t *testing.T) {
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecordsExclusiveSetIdentifierWithoutRoutingPolicyConfig(zoneName),
+				ExpectError: regexp.MustCompile(`set_identifier requires a routing policy`),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_Validation_aliasWithTTL(t *testing.T) {
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecordsExclusiveAliasWithTTLConfig(zoneName),
+				ExpectError: regexp.MustCompile(`alias conflicts with ttl and records`),
+			},
+		},
+	})
+}
+
+func testAccRecordsExclusiveCreateUnmanagedRecord(t *testing.T, zone *route53.GetHostedZoneOutput, name string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+	input := &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: zone.HostedZone.Id,
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action: aws.String(route53.ChangeActionCreate),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name: aws.String(name),
+						Type: aws.String(route53.RRTypeA),
+						TTL:  aws.Int64(300),
+						ResourceRecords: []*route53.ResourceRecord{
+							{Value: aws.String("127.0.0.3")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if _, err := tfroute53.ChangeRecordSet(conn, input); err != nil {
+		t.Fatalf("error creating Route 53 record (%s): %s", name, err)
+	}
+}
+
+func testAccCheckRecordsExclusiveRecordCount(zone *route53.GetHostedZoneOutput, name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		output, err := conn.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+			HostedZoneId:    zone.HostedZone.Id,
+			StartRecordName: aws.String(name),
+			StartRecordType: aws.String(route53.RRTypeA),
+			MaxItems:        aws.String("1"),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var count int
+		for _, recordSet := range output.ResourceRecordSets {
+			if aws.StringValue(recordSet.Name) == name && aws.StringValue(recordSet.Type) == route53.RRTypeA {
+				count++
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("expected %d Route 53 record sets named %s, got %d", expected, name, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordsExclusiveConfig(zoneName, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = [%[2]q]
+  }
+
+  resource_record_set {
+    name    = "mail.%[1]s"
+    type    = "TXT"
+    ttl     = 60
+    records = ["v=spf1 -all"]
+  }
+
+  exclusion {
+    name = "excluded.%[1]s"
+  }
+
+  exclusion {
+    name = "_acme-challenge"
+  }
+}
+`, zoneName, address)
+}
+
+func testAccRecordsExclusiveWeightedRoutingPolicyConfig(zoneName string, weight int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name           = "www"
+    type           = "A"
+    ttl            = 300
+    records        = ["127.0.0.1"]
+    set_identifier = "primary"
+
+    weighted_routing_policy {
+      weight = %[2]d
+    }
+  }
+
+  resource_record_set {
+    name           = "www"
+    type           = "A"
+    ttl            = 300
+    records        = ["127.0.0.2"]
+    set_identifier = "secondary"
+
+    weighted_routing_policy {
+      weight = 10
+    }
+  }
+}
+`, zoneName, weight)
+}
+
+func testAccRecordsExclusiveSetIdentifierWithoutRoutingPolicyConfig(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name           = "www"
+    type           = "A"
+    ttl            = 300
+    records        = ["127.0.0.1"]
+    set_identifier = "primary"
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsExclusiveAliasWithTTLConfig(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name = "www"
+    type = "A"
+    ttl  = 300
+
+    alias {
+      name                   = "web.%[1]s"
+      zone_id                = aws_route53_zone.test.zone_id
+      evaluate_target_health = false
+    }
+  }
+}
+`, zoneName)
+}
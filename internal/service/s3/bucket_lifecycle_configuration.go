@@ -0,0 +1,702 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketLifecycleConfigurationCreate,
+		ReadContext:   resourceBucketLifecycleConfigurationRead,
+		UpdateContext: resourceBucketLifecycleConfigurationUpdate,
+		DeleteContext: resourceBucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(lifecycleConfigurationRulesStatusReadyTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"object_size_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"object_size_less_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tags": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										MaxItems: 1,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.TransitionStorageClass_Values(), false),
+									},
+								},
+							},
+						},
+						"prefix": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateLifecycleRuleTopLevelPrefix,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ExpirationStatus_Values(), false),
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.TransitionStorageClass_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: ExpandLifecycleRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfigurationWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeOperationAborted)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating S3 bucket (%s) lifecycle configuration: %w", bucket, err))
+	}
+
+	d.SetId(CreateResourceID(bucket, expectedBucketOwner))
+
+	diags := lifecycleRuleTopLevelPrefixWarnings(d.Get("rule").([]interface{}))
+
+	return append(diags, resourceBucketLifecycleConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, expectedBucketOwner, err := ParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	expectedRules := len(d.Get("rule").([]interface{}))
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		output, err := conn.GetBucketLifecycleConfigurationWithContext(ctx, input)
+
+		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration) {
+			return nil, &resource.NotFoundError{LastError: err}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// S3 may briefly return only some of the rules of a newly created configuration.
+		if d.IsNewResource() && output != nil && len(output.Rules) < expectedRules {
+			return nil, &resource.NotFoundError{
+				Message: fmt.Sprintf("expected %d rules, got %d", expectedRules, len(output.Rules)),
+			}
+		}
+
+		return output, nil
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading S3 bucket lifecycle configuration (%s): %w", d.Id(), err))
+	}
+
+	output, ok := outputRaw.(*s3.GetBucketLifecycleConfigurationOutput)
+
+	if !ok || output == nil {
+		if d.IsNewResource() {
+			return diag.FromErr(fmt.Errorf("error reading S3 bucket lifecycle configuration (%s): empty output", d.Id()))
+		}
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
+
+	if err := d.Set("rule", FlattenLifecycleRules(output.Rules, d.Get("rule").([]interface{}))); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting rule: %w", err))
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, expectedBucketOwner, err := ParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rules := ExpandLifecycleRules(d.Get("rule").([]interface{}))
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfigurationWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeOperationAborted)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating S3 bucket lifecycle configuration (%s): %w", d.Id(), err))
+	}
+
+	if err := waitForLifecycleConfigurationRulesStatus(ctx, conn, bucket, expectedBucketOwner, rules, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for S3 bucket lifecycle configuration (%s) to reach expected rules status after update: %w", d.Id(), err))
+	}
+
+	diags := lifecycleRuleTopLevelPrefixWarnings(d.Get("rule").([]interface{}))
+
+	return append(diags, resourceBucketLifecycleConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, expectedBucketOwner, err := ParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err = conn.DeleteBucketLifecycleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting S3 bucket lifecycle configuration (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i, tfMapRaw := range diff.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		id := tfMap["id"].(string)
+
+		if !lifecycleRuleHasAction(tfMap) {
+			return fmt.Errorf("rule %s must specify at least one action", id)
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if lifecycleRuleFilterCombinesAndWithPredicates(v[0].(map[string]interface{})) {
+				return fmt.Errorf("rule %s filter: and cannot be combined with object_size_greater_than, object_size_less_than, prefix, or tag", id)
+			}
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 {
+			var date string
+			var days int
+			var expiredObjectDeleteMarker bool
+
+			if expiration, ok := v[0].(map[string]interface{}); ok {
+				date = expiration["date"].(string)
+				days = expiration["days"].(int)
+				expiredObjectDeleteMarker = expiration["expired_object_delete_marker"].(bool)
+			}
+
+			if days > 0 && expiredObjectDeleteMarker {
+				return fmt.Errorf("rule %s expiration: days and expired_object_delete_marker cannot both be specified", id)
+			}
+
+			// Values not known until apply read as their zero values.
+			if date == "" && days == 0 && !expiredObjectDeleteMarker && lifecycleRuleExpirationKnown(diff, i) {
+				return fmt.Errorf("rule %s expiration: one of date, days greater than 0, or expired_object_delete_marker must be specified", id)
+			}
+		}
+	}
+
+	return validateLifecycleRuleTransitions(diff.GetRawConfig())
+}
+
+// validateLifecycleRuleTransitions checks transitions against the raw configuration, in which
+// values not known until apply can be told apart from an explicit 0 within set elements.
+func validateLifecycleRuleTransitions(rawConfig cty.Value) error {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return nil
+	}
+
+	for _, rule := range ctyKnownElements(rawConfig.GetAttr("rule")) {
+		id, _ := ctyKnownString(rule.GetAttr("id"))
+
+		for _, transition := range ctyKnownElements(rule.GetAttr("transition")) {
+			storageClass, ok := ctyKnownString(transition.GetAttr("storage_class"))
+
+			if !ok {
+				continue
+			}
+
+			date := transition.GetAttr("date")
+
+			// Objects in INTELLIGENT_TIERING are tiered by access pattern, so transitions to it are days based only.
+			if storageClass == s3.TransitionStorageClassIntelligentTiering && !date.IsNull() {
+				return fmt.Errorf("rule %s transition: date cannot be specified for storage class %s, use days instead", id, storageClass)
+			}
+
+			if !date.IsNull() {
+				continue
+			}
+
+			v := transition.GetAttr("days")
+
+			if !v.IsKnown() {
+				continue
+			}
+
+			// Without date or days, the transition defaults to 0 days.
+			days := 0
+
+			if !v.IsNull() {
+				days = ctyInt(v)
+			}
+
+			if minimum := lifecycleTransitionMinimumDays[storageClass]; days < minimum {
+				return fmt.Errorf("rule %s transition: days must be at least %d for storage class %s, got %d", id, minimum, storageClass, days)
+			}
+		}
+
+		for _, transition := range ctyKnownElements(rule.GetAttr("noncurrent_version_transition")) {
+			storageClass, ok := ctyKnownString(transition.GetAttr("storage_class"))
+
+			if !ok {
+				continue
+			}
+
+			v := transition.GetAttr("noncurrent_days")
+
+			if !v.IsKnown() {
+				continue
+			}
+
+			days := 0
+
+			if !v.IsNull() {
+				days = ctyInt(v)
+			}
+
+			if minimum := lifecycleTransitionMinimumDays[storageClass]; days < minimum {
+				return fmt.Errorf("rule %s noncurrent_version_transition: noncurrent_days must be at least %d for storage class %s, got %d", id, minimum, storageClass, days)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ctyKnownElements(v cty.Value) []cty.Value {
+	if !v.IsKnown() || v.IsNull() || !v.CanIterateElements() {
+		return nil
+	}
+
+	var elements []cty.Value
+
+	for it := v.ElementIterator(); it.Next(); {
+		if _, element := it.Element(); element.IsKnown() && !element.IsNull() {
+			elements = append(elements, element)
+		}
+	}
+
+	return elements
+}
+
+func ctyKnownString(v cty.Value) (string, bool) {
+	if !v.IsKnown() || v.IsNull() {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+func ctyInt(v cty.Value) int {
+	i, _ := v.AsBigFloat().Int64()
+
+	return int(i)
+}
+
+// lifecycleTransitionMinimumDays holds the documented minimum number of days objects must be
+// stored before transitioning to each storage class.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/lifecycle-transition-general-considerations.html.
+var lifecycleTransitionMinimumDays = map[string]int{
+	s3.TransitionStorageClassDeepArchive:        0,
+	s3.TransitionStorageClassGlacier:            0,
+	s3.TransitionStorageClassGlacierIr:          0,
+	s3.TransitionStorageClassIntelligentTiering: 0,
+	s3.TransitionStorageClassOnezoneIa:          30,
+	s3.TransitionStorageClassStandardIa:         30,
+}
+
+// validateLifecycleRuleTopLevelPrefix warns at plan time that a rule uses the deprecated
+// top-level prefix rather than filter. The rule's id is not available here, so the rule is
+// identified by its position; lifecycleRuleTopLevelPrefixWarnings names it by id on apply.
+func validateLifecycleRuleTopLevelPrefix(v interface{}, path cty.Path) diag.Diagnostics {
+	prefix, ok := v.(string)
+
+	if !ok || prefix == "" {
+		return nil
+	}
+
+	rule := "A lifecycle rule"
+
+	if len(path) >= 2 {
+		if step, ok := path[1].(cty.IndexStep); ok && step.Key.Type() == cty.Number {
+			index, _ := step.Key.AsBigFloat().Int64()
+			rule = fmt.Sprintf("Lifecycle rule %d", index+1)
+		}
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("%s uses the deprecated top-level prefix", rule),
+			Detail:        lifecycleRuleTopLevelPrefixDetail(prefix),
+			AttributePath: path,
+		},
+	}
+}
+
+// lifecycleRuleTopLevelPrefixWarnings returns a warning naming each rule, by id, that uses the
+// deprecated top-level prefix rather than filter.
+func lifecycleRuleTopLevelPrefixWarnings(tfList []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		prefix, ok := tfMap["prefix"].(string)
+
+		if !ok || prefix == "" {
+			continue
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("Lifecycle rule (%s) uses the deprecated top-level prefix", tfMap["id"]),
+			Detail:        lifecycleRuleTopLevelPrefixDetail(prefix),
+			AttributePath: cty.GetAttrPath("rule").IndexInt(i).GetAttr("prefix"),
+		})
+	}
+
+	return diags
+}
+
+func lifecycleRuleTopLevelPrefixDetail(prefix string) string {
+	return fmt.Sprintf("Rules using the top-level prefix cannot be combined with rules using filter in the same configuration. Use filter { prefix = %q } instead.", prefix)
+}
+
+// lifecycleRuleExpirationKnown returns whether all arguments of the rule's expiration are known.
+func lifecycleRuleExpirationKnown(diff *schema.ResourceDiff, i int) bool {
+	for _, key := range []string{
+		"date",
+		"days",
+		"expired_object_delete_marker",
+	} {
+		if !diff.NewValueKnown(fmt.Sprintf("rule.%d.expiration.0.%s", i, key)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lifecycleRuleFilterCombinesAndWithPredicates returns whether the filter configures
+// the and block alongside any top-level predicate, which ExpandLifecycleRuleFilter would drop.
+func lifecycleRuleFilterCombinesAndWithPredicates(tfMap map[string]interface{}) bool {
+	if v, ok := tfMap["and"].([]interface{}); !ok || len(v) == 0 || v[0] == nil {
+		return false
+	}
+
+	if v, ok := tfMap["object_size_greater_than"].(int); ok && v > 0 {
+		return true
+	}
+
+	if v, ok := tfMap["object_size_less_than"].(int); ok && v > 0 {
+		return true
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		return true
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return true
+	}
+
+	return false
+}
+
+// lifecycleRuleHasAction returns whether the rule configures any action.
+// A filter alone does not constitute an action.
+func lifecycleRuleHasAction(tfMap map[string]interface{}) bool {
+	for _, key := range []string{
+		"abort_incomplete_multipart_upload",
+		"expiration",
+		"noncurrent_version_expiration",
+	} {
+		if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 {
+			return true
+		}
+	}
+
+	for _, key := range []string{
+		"noncurrent_version_transition",
+		"transition",
+	} {
+		if v, ok := tfMap[key].(*schema.Set); ok && v.Len() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
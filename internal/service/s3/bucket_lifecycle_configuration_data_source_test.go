@@ -0,0 +1,75 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketLifecycleConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_lifecycle_configuration.test"
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", resourceName, "bucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.id", resourceName, "rule.0.id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.status", resourceName, "rule.0.status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.filter.0.prefix", resourceName, "rule.0.filter.0.prefix"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.expiration.0.days", resourceName, "rule.0.expiration.0.days"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleConfigurationDataSource_noConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationDataSourceConfig_noConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketLifecycleConfigurationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketLifecycleConfigurationBasicConfig(rName), `
+data "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket_lifecycle_configuration.test.bucket
+}
+`)
+}
+
+func testAccBucketLifecycleConfigurationDataSourceConfig_noConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+}
+`, rName)
+}
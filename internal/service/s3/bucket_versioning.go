@@ -51,6 +51,7 @@ func ResourceBucketVersioning() *schema.Resource {
 						"mfa_delete": {
 							Type:         schema.TypeString,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.StringInSlice(s3.MFADelete_Values(), false),
 						},
 						"status": {
@@ -164,6 +165,16 @@ func resourceBucketVersioningUpdate(ctx context.Context, d *schema.ResourceData,
 		input.MFA = aws.String(v.(string))
 	}
 
+	// The MFA delete status can only be changed with an MFA token, so only
+	// send it when it changes; it may have been read back from the bucket.
+	if d.HasChange("versioning_configuration.0.mfa_delete") {
+		if input.MFA == nil {
+			return diag.Errorf("error updating S3 bucket versioning (%s): mfa is required to change versioning_configuration.0.mfa_delete", d.Id())
+		}
+	} else if input.VersioningConfiguration != nil {
+		input.VersioningConfiguration.MFADelete = nil
+	}
+
 	_, err = conn.PutBucketVersioningWithContext(ctx, input)
 
 	if err != nil {
@@ -194,6 +205,10 @@ func resourceBucketVersioningDelete(ctx context.Context, d *schema.ResourceData,
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
+	if v, ok := d.GetOk("mfa"); ok {
+		input.MFA = aws.String(v.(string))
+	}
+
 	_, err = conn.PutBucketVersioningWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
@@ -2,6 +2,7 @@ package s3_test
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -134,6 +135,43 @@ func TestAccS3BucketVersioning_MFADelete(t *testing.T) {
 	})
 }
 
+// TestAccS3BucketVersioning_MFADeleteEnabled requires an existing bucket with
+// MFA delete enabled, as enabling it needs the root account's MFA device.
+func TestAccS3BucketVersioning_MFADeleteEnabled(t *testing.T) {
+	key := "TF_ACC_S3_MFA_DELETE_BUCKET"
+	bucketName := os.Getenv(key)
+	if bucketName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_s3_bucket_versioning.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccBucketVersioningConfig_existingBucket(bucketName),
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: bucketName,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(s))
+					}
+
+					if v := s[0].Attributes["versioning_configuration.0.mfa_delete"]; v != s3.MFADeleteStatusEnabled {
+						return fmt.Errorf("expected versioning_configuration.0.mfa_delete to be %s, got %s", s3.MFADeleteStatusEnabled, v)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckBucketVersioningDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
 
@@ -227,3 +265,15 @@ resource "aws_s3_bucket_versioning" "test" {
 }
 `, rName, mfaDelete)
 }
+
+func testAccBucketVersioningConfig_existingBucket(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = %[1]q
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+`, bucketName)
+}
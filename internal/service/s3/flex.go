@@ -1,8 +1,12 @@
 package s3
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -161,6 +165,284 @@ func ExpandFilter(l []interface{}) *s3.ReplicationRuleFilter {
 	return result
 }
 
+func ExpandLifecycleRuleAbortIncompleteMultipartUpload(m map[string]interface{}) *s3.AbortIncompleteMultipartUpload {
+	if len(m) == 0 {
+		return nil
+	}
+
+	result := &s3.AbortIncompleteMultipartUpload{}
+
+	if v, ok := m["days_after_initiation"].(int); ok {
+		result.DaysAfterInitiation = aws.Int64(int64(v))
+	}
+
+	return result
+}
+
+func ExpandLifecycleRuleExpiration(l []interface{}) *s3.LifecycleExpiration {
+	if len(l) == 0 {
+		return nil
+	}
+
+	result := &s3.LifecycleExpiration{}
+
+	if l[0] == nil {
+		return result
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["date"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+		result.Date = aws.Time(t)
+	}
+
+	if v, ok := m["days"].(int); ok && v > 0 {
+		result.Days = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["expired_object_delete_marker"].(bool); ok && v {
+		result.ExpiredObjectDeleteMarker = aws.Bool(v)
+	}
+
+	return result
+}
+
+// ExpandLifecycleRuleFilter ensures a Filter can have only 1 of prefix, tag, object size, or and.
+// Multiple predicates configured at the top level of the filter are wrapped in an And operator.
+func ExpandLifecycleRuleFilter(l []interface{}) *s3.LifecycleRuleFilter {
+	if len(l) == 0 {
+		return nil
+	}
+
+	result := &s3.LifecycleRuleFilter{}
+
+	if l[0] == nil {
+		// An empty filter applies the rule to all objects in the bucket.
+		result.Prefix = aws.String("")
+		return result
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		result.And = ExpandLifecycleRuleFilterAndOperator(v[0].(map[string]interface{}))
+		return result
+	}
+
+	predicates := &s3.LifecycleRuleAndOperator{}
+
+	if v, ok := m["object_size_greater_than"].(int); ok && v > 0 {
+		predicates.ObjectSizeGreaterThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["object_size_less_than"].(int); ok && v > 0 {
+		predicates.ObjectSizeLessThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["prefix"].(string); ok && v != "" {
+		predicates.Prefix = aws.String(v)
+	}
+
+	if v, ok := m["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		predicates.Tags = []*s3.Tag{ExpandTag(v)}
+	}
+
+	switch {
+	case lifecycleRuleAndOperatorPredicateCount(predicates) > 1:
+		result.And = predicates
+	case predicates.ObjectSizeGreaterThan != nil:
+		result.ObjectSizeGreaterThan = predicates.ObjectSizeGreaterThan
+	case predicates.ObjectSizeLessThan != nil:
+		result.ObjectSizeLessThan = predicates.ObjectSizeLessThan
+	case len(predicates.Tags) > 0:
+		result.Tag = predicates.Tags[0]
+	default:
+		// Prefix is always set (even empty) when no other predicate is configured.
+		result.Prefix = aws.String(aws.StringValue(predicates.Prefix))
+	}
+
+	return result
+}
+
+func ExpandLifecycleRuleFilterAndOperator(m map[string]interface{}) *s3.LifecycleRuleAndOperator {
+	if len(m) == 0 {
+		return nil
+	}
+
+	result := &s3.LifecycleRuleAndOperator{}
+
+	if v, ok := m["object_size_greater_than"].(int); ok && v > 0 {
+		result.ObjectSizeGreaterThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["object_size_less_than"].(int); ok && v > 0 {
+		result.ObjectSizeLessThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["prefix"].(string); ok {
+		result.Prefix = aws.String(v)
+	}
+
+	if v, ok := m["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		tags := Tags(tftags.New(v).IgnoreAWS())
+		if len(tags) > 0 {
+			result.Tags = tags
+		}
+	}
+
+	return result
+}
+
+func ExpandLifecycleRuleNoncurrentVersionExpiration(m map[string]interface{}) *s3.NoncurrentVersionExpiration {
+	if len(m) == 0 {
+		return nil
+	}
+
+	result := &s3.NoncurrentVersionExpiration{}
+
+	if v, ok := m["newer_noncurrent_versions"].(int); ok && v > 0 {
+		result.NewerNoncurrentVersions = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["noncurrent_days"].(int); ok {
+		result.NoncurrentDays = aws.Int64(int64(v))
+	}
+
+	return result
+}
+
+func ExpandLifecycleRuleNoncurrentVersionTransitions(l []interface{}) []*s3.NoncurrentVersionTransition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	var results []*s3.NoncurrentVersionTransition
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		transition := &s3.NoncurrentVersionTransition{}
+
+		if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v > 0 {
+			transition.NewerNoncurrentVersions = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["noncurrent_days"].(int); ok {
+			transition.NoncurrentDays = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			transition.StorageClass = aws.String(v)
+		}
+
+		results = append(results, transition)
+	}
+
+	return results
+}
+
+func ExpandLifecycleRuleTransitions(l []interface{}) []*s3.Transition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	var results []*s3.Transition
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		transition := &s3.Transition{}
+
+		if v, ok := tfMap["date"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+			transition.Date = aws.Time(t)
+		}
+
+		// Only one of "date" and "days" can be configured
+		// so only set the transition.Days value when transition.Date is nil.
+		// By default, tfMap["days"] = 0 if not explicitly configured in terraform.
+		if v, ok := tfMap["days"].(int); ok && v >= 0 && transition.Date == nil {
+			transition.Days = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			transition.StorageClass = aws.String(v)
+		}
+
+		results = append(results, transition)
+	}
+
+	return results
+}
+
+func ExpandLifecycleRules(l []interface{}) []*s3.LifecycleRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	var results []*s3.LifecycleRule
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		result := &s3.LifecycleRule{}
+
+		if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			result.AbortIncompleteMultipartUpload = ExpandLifecycleRuleAbortIncompleteMultipartUpload(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 {
+			result.Expiration = ExpandLifecycleRuleExpiration(v)
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+			result.Filter = ExpandLifecycleRuleFilter(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && result.Filter == nil {
+			// XML schema V1
+			result.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok {
+			result.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			result.NoncurrentVersionExpiration = ExpandLifecycleRuleNoncurrentVersionExpiration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok && v.Len() > 0 {
+			result.NoncurrentVersionTransitions = ExpandLifecycleRuleNoncurrentVersionTransitions(v.List())
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			result.Status = aws.String(v)
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+			result.Transitions = ExpandLifecycleRuleTransitions(v.List())
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
 func ExpandMetrics(l []interface{}) *s3.Metrics {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -510,6 +792,276 @@ func FlattenFilter(filter *s3.ReplicationRuleFilter) []interface{} {
 	return []interface{}{m}
 }
 
+// FlattenLifecycleRules flattens the API rules. configuredRules, if any, are used to determine
+// whether a rule's And operator was configured as multiple top-level filter predicates so that
+// the filter is read back in the same shape it was written.
+func FlattenLifecycleRules(rules []*s3.LifecycleRule, configuredRules []interface{}) []interface{} {
+	if len(rules) == 0 {
+		return []interface{}{}
+	}
+
+	unwrapAnd := lifecycleRuleIDsWithTopLevelFilterPredicates(configuredRules)
+
+	var results []interface{}
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		m := make(map[string]interface{})
+
+		if rule.AbortIncompleteMultipartUpload != nil {
+			m["abort_incomplete_multipart_upload"] = FlattenLifecycleRuleAbortIncompleteMultipartUpload(rule.AbortIncompleteMultipartUpload)
+		}
+
+		if rule.Expiration != nil {
+			m["expiration"] = FlattenLifecycleRuleExpiration(rule.Expiration)
+		}
+
+		if rule.Filter != nil {
+			m["filter"] = FlattenLifecycleRuleFilter(rule.Filter, unwrapAnd[aws.StringValue(rule.ID)])
+		}
+
+		if rule.ID != nil {
+			m["id"] = aws.StringValue(rule.ID)
+		}
+
+		if rule.NoncurrentVersionExpiration != nil {
+			m["noncurrent_version_expiration"] = FlattenLifecycleRuleNoncurrentVersionExpiration(rule.NoncurrentVersionExpiration)
+		}
+
+		if rule.NoncurrentVersionTransitions != nil {
+			m["noncurrent_version_transition"] = FlattenLifecycleRuleNoncurrentVersionTransitions(rule.NoncurrentVersionTransitions)
+		}
+
+		if rule.Prefix != nil {
+			m["prefix"] = aws.StringValue(rule.Prefix)
+		}
+
+		if rule.Status != nil {
+			m["status"] = aws.StringValue(rule.Status)
+		}
+
+		if rule.Transitions != nil {
+			m["transition"] = FlattenLifecycleRuleTransitions(rule.Transitions)
+		}
+
+		results = append(results, m)
+	}
+
+	return results
+}
+
+func FlattenLifecycleRuleAbortIncompleteMultipartUpload(u *s3.AbortIncompleteMultipartUpload) []interface{} {
+	if u == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+
+	if u.DaysAfterInitiation != nil {
+		m["days_after_initiation"] = int(aws.Int64Value(u.DaysAfterInitiation))
+	}
+
+	return []interface{}{m}
+}
+
+func FlattenLifecycleRuleExpiration(expiration *s3.LifecycleExpiration) []interface{} {
+	if expiration == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+
+	if expiration.Date != nil {
+		m["date"] = (aws.TimeValue(expiration.Date)).Format("2006-01-02")
+	}
+
+	if expiration.Days != nil {
+		m["days"] = int(aws.Int64Value(expiration.Days))
+	}
+
+	if expiration.ExpiredObjectDeleteMarker != nil {
+		m["expired_object_delete_marker"] = aws.BoolValue(expiration.ExpiredObjectDeleteMarker)
+	}
+
+	return []interface{}{m}
+}
+
+// FlattenLifecycleRuleFilter flattens the API filter. When unwrapAnd is true and the And
+// operator holds no more than one tag, its predicates are flattened to the top level of the filter.
+func FlattenLifecycleRuleFilter(filter *s3.LifecycleRuleFilter, unwrapAnd bool) []interface{} {
+	if filter == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if filter.And != nil {
+		if !unwrapAnd || len(filter.And.Tags) > 1 {
+			m["and"] = FlattenLifecycleRuleFilterAndOperator(filter.And)
+
+			return []interface{}{m}
+		}
+
+		andOp := filter.And
+		filter = &s3.LifecycleRuleFilter{
+			ObjectSizeGreaterThan: andOp.ObjectSizeGreaterThan,
+			ObjectSizeLessThan:    andOp.ObjectSizeLessThan,
+			Prefix:                andOp.Prefix,
+		}
+
+		if len(andOp.Tags) == 1 {
+			filter.Tag = andOp.Tags[0]
+		}
+	}
+
+	if filter.ObjectSizeGreaterThan != nil {
+		m["object_size_greater_than"] = int(aws.Int64Value(filter.ObjectSizeGreaterThan))
+	}
+
+	if filter.ObjectSizeLessThan != nil {
+		m["object_size_less_than"] = int(aws.Int64Value(filter.ObjectSizeLessThan))
+	}
+
+	if filter.Prefix != nil {
+		m["prefix"] = aws.StringValue(filter.Prefix)
+	}
+
+	if filter.Tag != nil {
+		m["tag"] = FlattenLifecycleRuleFilterTag(filter.Tag)
+	}
+
+	return []interface{}{m}
+}
+
+func FlattenLifecycleRuleFilterAndOperator(andOp *s3.LifecycleRuleAndOperator) []interface{} {
+	if andOp == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if andOp.ObjectSizeGreaterThan != nil {
+		m["object_size_greater_than"] = int(aws.Int64Value(andOp.ObjectSizeGreaterThan))
+	}
+
+	if andOp.ObjectSizeLessThan != nil {
+		m["object_size_less_than"] = int(aws.Int64Value(andOp.ObjectSizeLessThan))
+	}
+
+	if andOp.Prefix != nil {
+		m["prefix"] = aws.StringValue(andOp.Prefix)
+	}
+
+	if andOp.Tags != nil {
+		m["tags"] = KeyValueTags(andOp.Tags).IgnoreAWS().Map()
+	}
+
+	return []interface{}{m}
+}
+
+func FlattenLifecycleRuleFilterTag(tag *s3.Tag) []interface{} {
+	if tag == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if tag.Key != nil {
+		m["key"] = aws.StringValue(tag.Key)
+	}
+
+	if tag.Value != nil {
+		m["value"] = aws.StringValue(tag.Value)
+	}
+
+	return []interface{}{m}
+}
+
+func FlattenLifecycleRuleNoncurrentVersionExpiration(expiration *s3.NoncurrentVersionExpiration) []interface{} {
+	if expiration == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if expiration.NewerNoncurrentVersions != nil {
+		m["newer_noncurrent_versions"] = int(aws.Int64Value(expiration.NewerNoncurrentVersions))
+	}
+
+	if expiration.NoncurrentDays != nil {
+		m["noncurrent_days"] = int(aws.Int64Value(expiration.NoncurrentDays))
+	}
+
+	return []interface{}{m}
+}
+
+func FlattenLifecycleRuleNoncurrentVersionTransitions(transitions []*s3.NoncurrentVersionTransition) []interface{} {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	var results []interface{}
+
+	for _, transition := range transitions {
+		if transition == nil {
+			continue
+		}
+
+		m := make(map[string]interface{})
+
+		if transition.NewerNoncurrentVersions != nil {
+			m["newer_noncurrent_versions"] = int(aws.Int64Value(transition.NewerNoncurrentVersions))
+		}
+
+		if transition.NoncurrentDays != nil {
+			m["noncurrent_days"] = int(aws.Int64Value(transition.NoncurrentDays))
+		}
+
+		if transition.StorageClass != nil {
+			m["storage_class"] = aws.StringValue(transition.StorageClass)
+		}
+
+		results = append(results, m)
+	}
+
+	return results
+}
+
+func FlattenLifecycleRuleTransitions(transitions []*s3.Transition) []interface{} {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	var results []interface{}
+
+	for _, transition := range transitions {
+		if transition == nil {
+			continue
+		}
+
+		m := make(map[string]interface{})
+
+		if transition.Date != nil {
+			m["date"] = (aws.TimeValue(transition.Date)).Format("2006-01-02")
+		}
+
+		if transition.Days != nil {
+			m["days"] = int(aws.Int64Value(transition.Days))
+		}
+
+		if transition.StorageClass != nil {
+			m["storage_class"] = aws.StringValue(transition.StorageClass)
+		}
+
+		results = append(results, m)
+	}
+
+	return results
+}
+
 func FlattenMetrics(metrics *s3.Metrics) []interface{} {
 	if metrics == nil {
 		return []interface{}{}
@@ -681,3 +1233,62 @@ func FlattenSseKmsEncryptedObjects(objects *s3.SseKmsEncryptedObjects) []interfa
 
 	return []interface{}{m}
 }
+
+// lifecycleRuleAndOperatorPredicateCount returns the number of predicates set on the And operator.
+func lifecycleRuleAndOperatorPredicateCount(andOp *s3.LifecycleRuleAndOperator) int {
+	if andOp == nil {
+		return 0
+	}
+
+	count := len(andOp.Tags)
+
+	if andOp.ObjectSizeGreaterThan != nil {
+		count++
+	}
+
+	if andOp.ObjectSizeLessThan != nil {
+		count++
+	}
+
+	if aws.StringValue(andOp.Prefix) != "" {
+		count++
+	}
+
+	return count
+}
+
+// lifecycleRuleIDsWithTopLevelFilterPredicates returns the IDs of the configured rules whose
+// filter sets multiple predicates outside of the and configuration block.
+func lifecycleRuleIDsWithTopLevelFilterPredicates(l []interface{}) map[string]bool {
+	results := make(map[string]bool)
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		id, ok := tfMap["id"].(string)
+
+		if !ok || id == "" {
+			continue
+		}
+
+		v, ok := tfMap["filter"].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		if and, ok := v[0].(map[string]interface{})["and"].([]interface{}); ok && len(and) > 0 && and[0] != nil {
+			continue
+		}
+
+		if filter := ExpandLifecycleRuleFilter(v); filter != nil && filter.And != nil {
+			results[id] = true
+		}
+	}
+
+	return results
+}
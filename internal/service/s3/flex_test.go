@@ -0,0 +1,175 @@
+package s3
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestExpandLifecycleRuleExpiration(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    []interface{}
+		Expected *s3.LifecycleExpiration
+	}{
+		{
+			TestName: "no expiration",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			TestName: "days",
+			Input: []interface{}{map[string]interface{}{
+				"date":                         "",
+				"days":                         90,
+				"expired_object_delete_marker": false,
+			}},
+			Expected: &s3.LifecycleExpiration{Days: aws.Int64(90)},
+		},
+		{
+			TestName: "expired object delete marker only",
+			Input: []interface{}{map[string]interface{}{
+				"date":                         "",
+				"days":                         0,
+				"expired_object_delete_marker": true,
+			}},
+			Expected: &s3.LifecycleExpiration{ExpiredObjectDeleteMarker: aws.Bool(true)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := ExpandLifecycleRuleExpiration(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandLifecycleRuleFilter(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    []interface{}
+		Expected *s3.LifecycleRuleFilter
+	}{
+		{
+			TestName: "no filter",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			TestName: "empty filter",
+			Input:    []interface{}{nil},
+			Expected: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+		},
+		{
+			TestName: "prefix only",
+			Input: []interface{}{map[string]interface{}{
+				"and":    []interface{}{},
+				"prefix": "logs/",
+				"tag":    []interface{}{},
+			}},
+			Expected: &s3.LifecycleRuleFilter{Prefix: aws.String("logs/")},
+		},
+		{
+			TestName: "tag only",
+			Input: []interface{}{map[string]interface{}{
+				"and":    []interface{}{},
+				"prefix": "",
+				"tag": []interface{}{map[string]interface{}{
+					"key":   "Key1",
+					"value": "Value1",
+				}},
+			}},
+			Expected: &s3.LifecycleRuleFilter{
+				Tag: &s3.Tag{Key: aws.String("Key1"), Value: aws.String("Value1")},
+			},
+		},
+		{
+			TestName: "prefix and tag",
+			Input: []interface{}{map[string]interface{}{
+				"and":    []interface{}{},
+				"prefix": "logs/",
+				"tag": []interface{}{map[string]interface{}{
+					"key":   "Key1",
+					"value": "Value1",
+				}},
+			}},
+			Expected: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					Prefix: aws.String("logs/"),
+					Tags:   []*s3.Tag{{Key: aws.String("Key1"), Value: aws.String("Value1")}},
+				},
+			},
+		},
+		{
+			TestName: "prefix and object size",
+			Input: []interface{}{map[string]interface{}{
+				"and":                      []interface{}{},
+				"object_size_greater_than": 500,
+				"prefix":                   "logs/",
+			}},
+			Expected: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					ObjectSizeGreaterThan: aws.Int64(500),
+					Prefix:                aws.String("logs/"),
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := ExpandLifecycleRuleFilter(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenLifecycleRulesFilterRoundTrip(t *testing.T) {
+	configured := []interface{}{map[string]interface{}{
+		"id": "test",
+		"filter": []interface{}{map[string]interface{}{
+			"and":    []interface{}{},
+			"prefix": "logs/",
+			"tag": []interface{}{map[string]interface{}{
+				"key":   "Key1",
+				"value": "Value1",
+			}},
+		}},
+	}}
+
+	rules := []*s3.LifecycleRule{{
+		ID:     aws.String("test"),
+		Status: aws.String(s3.ExpirationStatusEnabled),
+		Filter: ExpandLifecycleRuleFilter(configured[0].(map[string]interface{})["filter"].([]interface{})),
+	}}
+
+	expected := []interface{}{map[string]interface{}{
+		"prefix": "logs/",
+		"tag": []interface{}{map[string]interface{}{
+			"key":   "Key1",
+			"value": "Value1",
+		}},
+	}}
+
+	got := FlattenLifecycleRules(rules, configured)[0].(map[string]interface{})["filter"]
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	// Without configuration, e.g. on import, the predicates are flattened within the and block.
+	got = FlattenLifecycleRules(rules, nil)[0].(map[string]interface{})["filter"]
+
+	if _, ok := got.([]interface{})[0].(map[string]interface{})["and"]; !ok {
+		t.Errorf("expected and block, got %v", got)
+	}
+}
@@ -0,0 +1,71 @@
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	LifecycleConfigurationRulesStatusReady    = "READY"
+	LifecycleConfigurationRulesStatusNotReady = "NOT_READY"
+)
+
+func lifecycleConfigurationRulesStatus(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string, rules []*s3.LifecycleRule) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(bucket),
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		output, err := conn.GetBucketLifecycleConfigurationWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration, s3.ErrCodeNoSuchBucket) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		if len(lifecycleConfigurationRulesNotReady(output.Rules, rules)) > 0 {
+			return output, LifecycleConfigurationRulesStatusNotReady, nil
+		}
+
+		return output, LifecycleConfigurationRulesStatusReady, nil
+	}
+}
+
+// lifecycleConfigurationRulesNotReady returns the IDs of the expected rules that are
+// missing from the actual rules or whose status does not yet match.
+func lifecycleConfigurationRulesNotReady(actualRules, expectedRules []*s3.LifecycleRule) []string {
+	var ids []string
+
+	for _, expectedRule := range expectedRules {
+		ready := false
+
+		for _, actualRule := range actualRules {
+			if aws.StringValue(actualRule.ID) != aws.StringValue(expectedRule.ID) {
+				continue
+			}
+
+			ready = aws.StringValue(actualRule.Status) == aws.StringValue(expectedRule.Status)
+		}
+
+		if !ready {
+			ids = append(ids, aws.StringValue(expectedRule.ID))
+		}
+	}
+
+	return ids
+}
@@ -1,7 +1,12 @@
 package s3
 
 import (
+	"regexp"
+	"strings"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-cty/cty"
 )
 
 func TestValidBucketLifecycleTimestamp(t *testing.T) {
@@ -29,3 +34,176 @@ func TestValidBucketLifecycleTimestamp(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateLifecycleRuleTransitions(t *testing.T) {
+	transition := func(storageClass string, date, days cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"date":          date,
+			"days":          days,
+			"storage_class": cty.StringVal(storageClass),
+		})
+	}
+	noncurrentVersionTransition := func(storageClass string, noncurrentDays cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"noncurrent_days": noncurrentDays,
+			"storage_class":   cty.StringVal(storageClass),
+		})
+	}
+	config := func(transitions, noncurrentVersionTransitions []cty.Value) cty.Value {
+		transitionsVal := cty.SetValEmpty(cty.Object(map[string]cty.Type{"date": cty.String, "days": cty.Number, "storage_class": cty.String}))
+		if len(transitions) > 0 {
+			transitionsVal = cty.SetVal(transitions)
+		}
+		noncurrentVersionTransitionsVal := cty.SetValEmpty(cty.Object(map[string]cty.Type{"noncurrent_days": cty.Number, "storage_class": cty.String}))
+		if len(noncurrentVersionTransitions) > 0 {
+			noncurrentVersionTransitionsVal = cty.SetVal(noncurrentVersionTransitions)
+		}
+
+		return cty.ObjectVal(map[string]cty.Value{
+			"rule": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"id":                            cty.StringVal("test"),
+					"noncurrent_version_transition": noncurrentVersionTransitionsVal,
+					"transition":                    transitionsVal,
+				}),
+			}),
+		})
+	}
+
+	testCases := []struct {
+		TestName    string
+		Config      cty.Value
+		ExpectError *regexp.Regexp
+	}{
+		{
+			TestName: "null configuration",
+			Config:   cty.NullVal(cty.DynamicPseudoType),
+		},
+		{
+			TestName: "standard_ia days at minimum",
+			Config:   config([]cty.Value{transition(s3.TransitionStorageClassStandardIa, cty.NullVal(cty.String), cty.NumberIntVal(30))}, nil),
+		},
+		{
+			TestName:    "standard_ia days below minimum",
+			Config:      config([]cty.Value{transition(s3.TransitionStorageClassStandardIa, cty.NullVal(cty.String), cty.NumberIntVal(7))}, nil),
+			ExpectError: regexp.MustCompile(`rule test transition: days must be at least 30 for storage class STANDARD_IA, got 7`),
+		},
+		{
+			TestName:    "onezone_ia days defaulted",
+			Config:      config([]cty.Value{transition(s3.TransitionStorageClassOnezoneIa, cty.NullVal(cty.String), cty.NullVal(cty.Number))}, nil),
+			ExpectError: regexp.MustCompile(`days must be at least 30 for storage class ONEZONE_IA, got 0`),
+		},
+		{
+			TestName: "standard_ia days unknown",
+			Config:   config([]cty.Value{transition(s3.TransitionStorageClassStandardIa, cty.NullVal(cty.String), cty.UnknownVal(cty.Number))}, nil),
+		},
+		{
+			TestName: "standard_ia date",
+			Config:   config([]cty.Value{transition(s3.TransitionStorageClassStandardIa, cty.StringVal("2023-01-13"), cty.NullVal(cty.Number))}, nil),
+		},
+		{
+			TestName: "intelligent_tiering days",
+			Config:   config([]cty.Value{transition(s3.TransitionStorageClassIntelligentTiering, cty.NullVal(cty.String), cty.NumberIntVal(0))}, nil),
+		},
+		{
+			TestName:    "intelligent_tiering date",
+			Config:      config([]cty.Value{transition(s3.TransitionStorageClassIntelligentTiering, cty.StringVal("2023-01-13"), cty.NullVal(cty.Number))}, nil),
+			ExpectError: regexp.MustCompile(`rule test transition: date cannot be specified for storage class INTELLIGENT_TIERING`),
+		},
+		{
+			TestName:    "intelligent_tiering date unknown",
+			Config:      config([]cty.Value{transition(s3.TransitionStorageClassIntelligentTiering, cty.UnknownVal(cty.String), cty.NullVal(cty.Number))}, nil),
+			ExpectError: regexp.MustCompile(`date cannot be specified for storage class INTELLIGENT_TIERING`),
+		},
+		{
+			TestName:    "noncurrent standard_ia days below minimum",
+			Config:      config(nil, []cty.Value{noncurrentVersionTransition(s3.TransitionStorageClassStandardIa, cty.NumberIntVal(1))}),
+			ExpectError: regexp.MustCompile(`rule test noncurrent_version_transition: noncurrent_days must be at least 30 for storage class STANDARD_IA, got 1`),
+		},
+		{
+			TestName: "noncurrent standard_ia days unknown",
+			Config:   config(nil, []cty.Value{noncurrentVersionTransition(s3.TransitionStorageClassStandardIa, cty.UnknownVal(cty.Number))}),
+		},
+		{
+			TestName: "noncurrent glacier days",
+			Config:   config(nil, []cty.Value{noncurrentVersionTransition(s3.TransitionStorageClassGlacier, cty.NumberIntVal(1))}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := validateLifecycleRuleTransitions(testCase.Config)
+
+			if testCase.ExpectError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectError.MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %q", testCase.ExpectError, err)
+			}
+		})
+	}
+}
+
+func TestValidateLifecycleRuleTopLevelPrefix(t *testing.T) {
+	path := cty.GetAttrPath("rule").IndexInt(1).GetAttr("prefix")
+
+	if diags := validateLifecycleRuleTopLevelPrefix("", path); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics for empty prefix, got %v", diags)
+	}
+
+	diags := validateLifecycleRuleTopLevelPrefix("log/", path)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+	}
+
+	if diags.HasError() {
+		t.Fatalf("expected warning, got error: %s", diags[0].Summary)
+	}
+
+	if !diags[0].AttributePath.Equals(path) {
+		t.Errorf("got attribute path %#v, expected %#v", diags[0].AttributePath, path)
+	}
+
+	if expected := "Lifecycle rule 2 uses the deprecated top-level prefix"; diags[0].Summary != expected {
+		t.Errorf("got summary %q, expected %q", diags[0].Summary, expected)
+	}
+
+	if expected := `Use filter { prefix = "log/" } instead.`; !strings.Contains(diags[0].Detail, expected) {
+		t.Errorf("got detail %q, expected it to contain %q", diags[0].Detail, expected)
+	}
+}
+
+func TestLifecycleRuleTopLevelPrefixWarnings(t *testing.T) {
+	tfList := []interface{}{
+		map[string]interface{}{"id": "filtered", "prefix": ""},
+		map[string]interface{}{"id": "logs", "prefix": "log/"},
+	}
+
+	diags := lifecycleRuleTopLevelPrefixWarnings(tfList)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+	}
+
+	if diags.HasError() {
+		t.Fatalf("expected warning, got error: %s", diags[0].Summary)
+	}
+
+	if expected := "Lifecycle rule (logs) uses the deprecated top-level prefix"; diags[0].Summary != expected {
+		t.Errorf("got summary %q, expected %q", diags[0].Summary, expected)
+	}
+
+	if path := cty.GetAttrPath("rule").IndexInt(1).GetAttr("prefix"); !diags[0].AttributePath.Equals(path) {
+		t.Errorf("got attribute path %#v, expected %#v", diags[0].AttributePath, path)
+	}
+}
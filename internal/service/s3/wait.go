@@ -1,17 +1,81 @@
 package s3
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	bucketCreatedTimeout = 2 * time.Minute
 	propagationTimeout   = 1 * time.Minute
+
+	lifecycleConfigurationRulesStatusReadyTimeout = 3 * time.Minute
 )
 
 func retryWhenBucketNotFound(f func() (interface{}, error)) (interface{}, error) {
 	return tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, f, s3.ErrCodeNoSuchBucket)
 }
+
+func waitForLifecycleConfigurationRulesStatus(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string, rules []*s3.LifecycleRule, timeout time.Duration) error {
+	// Remember the rules last seen so that a timeout can report which rules never reached
+	// the expected status, even when the operation's context has already expired.
+	var mu sync.Mutex
+	var lastRules []*s3.LifecycleRule
+	refresh := lifecycleConfigurationRulesStatus(ctx, conn, bucket, expectedBucketOwner, rules)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"", LifecycleConfigurationRulesStatusNotReady},
+		Target:  []string{LifecycleConfigurationRulesStatusReady},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if output, ok := outputRaw.(*s3.GetBucketLifecycleConfigurationOutput); ok {
+				mu.Lock()
+				lastRules = output.Rules
+				mu.Unlock()
+			}
+
+			return outputRaw, status, err
+		},
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+		NotFoundChecks:            20,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	if tfresource.TimedOut(err) || errors.Is(err, context.DeadlineExceeded) {
+		mu.Lock()
+		notReadyErr := lifecycleConfigurationRulesNotReadyError(lastRules, rules)
+		mu.Unlock()
+
+		if notReadyErr != nil {
+			if tfresource.TimedOut(err) {
+				tfresource.SetLastError(err, notReadyErr)
+			} else {
+				err = fmt.Errorf("%w: %s", err, notReadyErr)
+			}
+		}
+	}
+
+	return err
+}
+
+func lifecycleConfigurationRulesNotReadyError(actualRules, expectedRules []*s3.LifecycleRule) error {
+	ids := lifecycleConfigurationRulesNotReady(actualRules, expectedRules)
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("rules (%s) did not reach expected status", strings.Join(ids, ", "))
+}
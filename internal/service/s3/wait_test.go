@@ -0,0 +1,75 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestLifecycleConfigurationRulesNotReadyError(t *testing.T) {
+	expectedRules := []*s3.LifecycleRule{
+		{ID: aws.String("rule1"), Status: aws.String(s3.ExpirationStatusEnabled)},
+		{ID: aws.String("rule2"), Status: aws.String(s3.ExpirationStatusEnabled)},
+		{ID: aws.String("rule3"), Status: aws.String(s3.ExpirationStatusDisabled)},
+	}
+
+	testCases := []struct {
+		TestName    string
+		ActualRules []*s3.LifecycleRule
+		Expected    string
+	}{
+		{
+			TestName: "all rules ready",
+			ActualRules: []*s3.LifecycleRule{
+				{ID: aws.String("rule1"), Status: aws.String(s3.ExpirationStatusEnabled)},
+				{ID: aws.String("rule2"), Status: aws.String(s3.ExpirationStatusEnabled)},
+				{ID: aws.String("rule3"), Status: aws.String(s3.ExpirationStatusDisabled)},
+			},
+		},
+		{
+			TestName: "missing and mismatched rules",
+			ActualRules: []*s3.LifecycleRule{
+				{ID: aws.String("rule1"), Status: aws.String(s3.ExpirationStatusEnabled)},
+				{ID: aws.String("rule3"), Status: aws.String(s3.ExpirationStatusEnabled)},
+			},
+			Expected: "timeout while waiting for state to become 'READY' (last state: 'NOT_READY', timeout: 3m0s): rules (rule2, rule3) did not reach expected status",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			notReadyErr := lifecycleConfigurationRulesNotReadyError(testCase.ActualRules, expectedRules)
+
+			if testCase.Expected == "" {
+				if notReadyErr != nil {
+					t.Fatalf("unexpected error: %s", notReadyErr)
+				}
+
+				return
+			}
+
+			if notReadyErr == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			err := &resource.TimeoutError{
+				ExpectedState: []string{LifecycleConfigurationRulesStatusReady},
+				LastState:     LifecycleConfigurationRulesStatusNotReady,
+				Timeout:       lifecycleConfigurationRulesStatusReadyTimeout,
+			}
+
+			if !tfresource.TimedOut(err) {
+				t.Fatal("expected timeout error")
+			}
+
+			tfresource.SetLastError(err, notReadyErr)
+
+			if got := err.Error(); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package scheduler
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindScheduleByTwoPartKey(conn *scheduler.Scheduler, groupName, name string) (*scheduler.GetScheduleOutput, error) {
+	input := &scheduler.GetScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetSchedule(input)
+
+	if tfawserr.ErrCodeEquals(err, scheduler.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
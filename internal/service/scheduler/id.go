@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+)
+
+const scheduleResourceIDSeparator = "/"
+
+func ScheduleCreateResourceID(groupName, scheduleName string) string {
+	parts := []string{groupName, scheduleName}
+	id := strings.Join(parts, scheduleResourceIDSeparator)
+
+	return id
+}
+
+func ScheduleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, scheduleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GROUP_NAME%[2]sSCHEDULE_NAME", id, scheduleResourceIDSeparator)
+}
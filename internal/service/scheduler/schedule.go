@@ -0,0 +1,537 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	DefaultScheduleGroupName = "default"
+)
+
+func ResourceSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceScheduleCreate,
+		Read:   resourceScheduleRead,
+		Update: resourceScheduleUpdate,
+		Delete: resourceScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action_after_completion": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(scheduler.ActionAfterCompletion_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"end_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"flexible_time_window": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_window_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 1440),
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(scheduler.FlexibleTimeWindowMode_Values(), false),
+						},
+					},
+				},
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      DefaultScheduleGroupName,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64),
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64-resource.UniqueIDSuffixLength),
+				ConflictsWith: []string{"name"},
+			},
+			"schedule_expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"schedule_expression_timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "UTC",
+			},
+			"start_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      scheduler.ScheduleStateEnabled,
+				ValidateFunc: validation.StringInSlice(scheduler.ScheduleState_Values(), false),
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"dead_letter_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"input": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 8192),
+						},
+						"retry_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum_event_age_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(60, 86400),
+									},
+									"maximum_retry_attempts": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 185),
+									},
+								},
+							},
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName := d.Get("group_name").(string)
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &scheduler.CreateScheduleInput{
+		FlexibleTimeWindow: expandFlexibleTimeWindow(d.Get("flexible_time_window").([]interface{})),
+		GroupName:          aws.String(groupName),
+		Name:               aws.String(name),
+		ScheduleExpression: aws.String(d.Get("schedule_expression").(string)),
+		State:              aws.String(d.Get("state").(string)),
+		Target:             expandTarget(d.Get("target").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("action_after_completion"); ok {
+		input.ActionAfterCompletion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDate = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schedule_expression_timezone"); ok {
+		input.ScheduleExpressionTimezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.StartDate = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Scheduler Schedule: %s", input)
+	_, err := conn.CreateSchedule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EventBridge Scheduler Schedule (%s): %w", name, err)
+	}
+
+	d.SetId(ScheduleCreateResourceID(groupName, name))
+
+	return resourceScheduleRead(d, meta)
+}
+
+func resourceScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, name, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindScheduleByTwoPartKey(conn, groupName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Scheduler Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Scheduler Schedule (%s): %w", d.Id(), err)
+	}
+
+	d.Set("action_after_completion", output.ActionAfterCompletion)
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	if output.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(output.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	if err := d.Set("flexible_time_window", flattenFlexibleTimeWindow(output.FlexibleTimeWindow)); err != nil {
+		return fmt.Errorf("error setting flexible_time_window: %w", err)
+	}
+	d.Set("group_name", output.GroupName)
+	d.Set("kms_key_arn", output.KmsKeyArn)
+	d.Set("name", output.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("schedule_expression", output.ScheduleExpression)
+	d.Set("schedule_expression_timezone", output.ScheduleExpressionTimezone)
+	if output.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("state", output.State)
+	if err := d.Set("target", flattenTarget(output.Target)); err != nil {
+		return fmt.Errorf("error setting target: %w", err)
+	}
+
+	return nil
+}
+
+func resourceScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, name, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// UpdateSchedule replaces the whole schedule, so every argument is sent, not just the changed ones.
+	input := &scheduler.UpdateScheduleInput{
+		FlexibleTimeWindow: expandFlexibleTimeWindow(d.Get("flexible_time_window").([]interface{})),
+		GroupName:          aws.String(groupName),
+		Name:               aws.String(name),
+		ScheduleExpression: aws.String(d.Get("schedule_expression").(string)),
+		State:              aws.String(d.Get("state").(string)),
+		Target:             expandTarget(d.Get("target").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("action_after_completion"); ok {
+		input.ActionAfterCompletion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDate = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schedule_expression_timezone"); ok {
+		input.ScheduleExpressionTimezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.StartDate = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Updating EventBridge Scheduler Schedule: %s", input)
+	_, err = conn.UpdateSchedule(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating EventBridge Scheduler Schedule (%s): %w", d.Id(), err)
+	}
+
+	return resourceScheduleRead(d, meta)
+}
+
+func resourceScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, name, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EventBridge Scheduler Schedule: %s", d.Id())
+	_, err = conn.DeleteSchedule(&scheduler.DeleteScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, scheduler.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EventBridge Scheduler Schedule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceScheduleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("start_date") || !diff.NewValueKnown("end_date") {
+		return nil
+	}
+
+	startDate, endDate := diff.Get("start_date").(string), diff.Get("end_date").(string)
+
+	if startDate == "" || endDate == "" {
+		return nil
+	}
+
+	start, err := time.Parse(time.RFC3339, startDate)
+
+	if err != nil {
+		return fmt.Errorf("error parsing start_date (%s): %w", startDate, err)
+	}
+
+	end, err := time.Parse(time.RFC3339, endDate)
+
+	if err != nil {
+		return fmt.Errorf("error parsing end_date (%s): %w", endDate, err)
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("end_date (%s) must be after start_date (%s)", endDate, startDate)
+	}
+
+	return nil
+}
+
+// suppressEquivalentTime suppresses diffs between RFC3339 timestamps that denote the same instant.
+// The API returns dates in UTC, whatever offset they were configured with.
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+
+	if err != nil {
+		return false
+	}
+
+	n, err := time.Parse(time.RFC3339, new)
+
+	if err != nil {
+		return false
+	}
+
+	return o.Equal(n)
+}
+
+func expandFlexibleTimeWindow(tfList []interface{}) *scheduler.FlexibleTimeWindow {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &scheduler.FlexibleTimeWindow{
+		Mode: aws.String(tfMap["mode"].(string)),
+	}
+
+	if v, ok := tfMap["maximum_window_in_minutes"].(int); ok && v != 0 {
+		apiObject.MaximumWindowInMinutes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandTarget(tfList []interface{}) *scheduler.Target {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &scheduler.Target{
+		Arn:     aws.String(tfMap["arn"].(string)),
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = &scheduler.DeadLetterConfig{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["input"].(string); ok && v != "" {
+		apiObject.Input = aws.String(v)
+	}
+
+	if v, ok := tfMap["retry_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RetryPolicy = expandRetryPolicy(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandRetryPolicy(tfMap map[string]interface{}) *scheduler.RetryPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &scheduler.RetryPolicy{}
+
+	if v, ok := tfMap["maximum_event_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumEventAgeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok {
+		apiObject.MaximumRetryAttempts = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenFlexibleTimeWindow(apiObject *scheduler.FlexibleTimeWindow) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"maximum_window_in_minutes": aws.Int64Value(apiObject.MaximumWindowInMinutes),
+		"mode":                      aws.StringValue(apiObject.Mode),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTarget(apiObject *scheduler.Target) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":          aws.StringValue(apiObject.Arn),
+		"input":        aws.StringValue(apiObject.Input),
+		"retry_policy": flattenRetryPolicy(apiObject.RetryPolicy),
+		"role_arn":     aws.StringValue(apiObject.RoleArn),
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil && v.Arn != nil {
+		tfMap["dead_letter_config"] = []interface{}{map[string]interface{}{
+			"arn": aws.StringValue(v.Arn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRetryPolicy(apiObject *scheduler.RetryPolicy) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"maximum_event_age_in_seconds": aws.Int64Value(apiObject.MaximumEventAgeInSeconds),
+		"maximum_retry_attempts":       aws.Int64Value(apiObject.MaximumRetryAttempts),
+	}
+
+	return []interface{}{tfMap}
+}
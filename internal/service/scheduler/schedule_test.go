@@ -0,0 +1,334 @@
+package scheduler_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSchedulerSchedule_basic(t *testing.T) {
+	var v scheduler.GetScheduleOutput
+	resourceName := "aws_scheduler_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "scheduler", regexp.MustCompile(`schedule/default/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "group_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "start_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_disappears(t *testing.T) {
+	var v scheduler.GetScheduleOutput
+	resourceName := "aws_scheduler_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfscheduler.ResourceSchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_startDateEndDate(t *testing.T) {
+	var v scheduler.GetScheduleOutput
+	resourceName := "aws_scheduler_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleStartDateEndDateConfig(rName, "2100-01-01T00:00:00Z", "2100-02-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2100-02-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "start_date", "2100-01-01T00:00:00Z"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The API returns dates in UTC, so an equivalent date with an offset must not show a diff.
+				Config:   testAccScheduleStartDateEndDateConfig(rName, "2100-01-01T02:00:00+02:00", "2100-02-01T00:00:00Z"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccScheduleStartDateEndDateConfig(rName, "2100-01-15T00:00:00Z", "2100-03-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2100-03-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "start_date", "2100-01-15T00:00:00Z"),
+				),
+			},
+			{
+				Config: testAccScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "start_date", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_Validation_endDateBeforeStartDate(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleStartDateEndDateConfig(rName, "2100-02-01T00:00:00Z", "2100-01-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`end_date .* must be after start_date`),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_flexibleTimeWindow(t *testing.T) {
+	var v scheduler.GetScheduleOutput
+	resourceName := "aws_scheduler_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleFlexibleTimeWindowConfig(rName, 15),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.maximum_window_in_minutes", "15"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "FLEXIBLE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleFlexibleTimeWindowConfig(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.maximum_window_in_minutes", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduleExists(n string, v *scheduler.GetScheduleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Scheduler Schedule ID is set")
+		}
+
+		groupName, name, err := tfscheduler.ScheduleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerConn
+
+		output, err := tfscheduler.FindScheduleByTwoPartKey(conn, groupName, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckScheduleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_scheduler_schedule" {
+			continue
+		}
+
+		groupName, name, err := tfscheduler.ScheduleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfscheduler.FindScheduleByTwoPartKey(conn, groupName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EventBridge Scheduler Schedule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccScheduleBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "scheduler.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "sqs:SendMessage"
+      Effect   = "Allow"
+      Resource = aws_sqs_queue.test.arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccScheduleConfig(rName string) string {
+	return acctest.ConfigCompose(testAccScheduleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccScheduleStartDateEndDateConfig(rName, startDate, endDate string) string {
+	return acctest.ConfigCompose(testAccScheduleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+  start_date          = %[2]q
+  end_date            = %[3]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, rName, startDate, endDate))
+}
+
+func testAccScheduleFlexibleTimeWindowConfig(rName string, maximumWindowInMinutes int) string {
+	return acctest.ConfigCompose(testAccScheduleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+
+  flexible_time_window {
+    maximum_window_in_minutes = %[2]d
+    mode                      = "FLEXIBLE"
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, rName, maximumWindowInMinutes))
+}
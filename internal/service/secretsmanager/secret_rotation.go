@@ -35,9 +35,18 @@ func ResourceSecretRotation() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotate_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"rotation_lambda_arn": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"rotation_rules": {
 				Type:     schema.TypeList,
@@ -61,40 +70,14 @@ func resourceSecretRotationCreate(d *schema.ResourceData, meta interface{}) erro
 	conn := meta.(*conns.AWSClient).SecretsManagerConn
 	secretID := d.Get("secret_id").(string)
 
-	if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
-		input := &secretsmanager.RotateSecretInput{
-			RotationLambdaARN: aws.String(v.(string)),
-			RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
-			SecretId:          aws.String(secretID),
-		}
+	output, err := rotateSecret(conn, d, secretID)
 
-		log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
-		var output *secretsmanager.RotateSecretOutput
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			var err error
-			output, err = conn.RotateSecret(input)
-			if err != nil {
-				// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
-				if tfawserr.ErrMessageContains(err, "AccessDeniedException", "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-
-			return nil
-		})
-
-		if tfresource.TimedOut(err) {
-			output, err = conn.RotateSecret(input)
-		}
-
-		if err != nil {
-			return fmt.Errorf("error enabling Secrets Manager Secret %q rotation: %s", d.Id(), err)
-		}
-
-		d.SetId(aws.StringValue(output.ARN))
+	if err != nil {
+		return fmt.Errorf("error enabling Secrets Manager Secret %q rotation: %w", secretID, err)
 	}
 
+	d.SetId(aws.StringValue(output.ARN))
+
 	return resourceSecretRotationRead(d, meta)
 }
 
@@ -144,6 +127,12 @@ func resourceSecretRotationRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("secret_id", d.Id())
 	d.Set("rotation_enabled", output.RotationEnabled)
 
+	if output.NextRotationDate != nil {
+		d.Set("next_rotation_date", aws.TimeValue(output.NextRotationDate).Format(time.RFC3339))
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
+
 	if aws.BoolValue(output.RotationEnabled) {
 		d.Set("rotation_lambda_arn", output.RotationLambdaARN)
 		if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
@@ -162,43 +151,8 @@ func resourceSecretRotationUpdate(d *schema.ResourceData, meta interface{}) erro
 	secretID := d.Get("secret_id").(string)
 
 	if d.HasChanges("rotation_lambda_arn", "rotation_rules") {
-		if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
-			input := &secretsmanager.RotateSecretInput{
-				RotationLambdaARN: aws.String(v.(string)),
-				RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
-				SecretId:          aws.String(secretID),
-			}
-
-			log.Printf("[DEBUG] Enabling Secrets Manager Secret Rotation: %s", input)
-			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-				_, err := conn.RotateSecret(input)
-				if err != nil {
-					// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
-					if tfawserr.ErrMessageContains(err, "AccessDeniedException", "") {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-
-			if tfresource.TimedOut(err) {
-				_, err = conn.RotateSecret(input)
-			}
-
-			if err != nil {
-				return fmt.Errorf("error updating Secrets Manager Secret Rotation %q : %s", d.Id(), err)
-			}
-		} else {
-			input := &secretsmanager.CancelRotateSecretInput{
-				SecretId: aws.String(d.Id()),
-			}
-
-			log.Printf("[DEBUG] Cancelling Secrets Manager Secret Rotation: %s", input)
-			_, err := conn.CancelRotateSecret(input)
-			if err != nil {
-				return fmt.Errorf("error cancelling Secret Manager Secret Rotation %q : %s", d.Id(), err)
-			}
+		if _, err := rotateSecret(conn, d, secretID); err != nil {
+			return fmt.Errorf("error updating Secrets Manager Secret Rotation %q : %w", d.Id(), err)
 		}
 	}
 
@@ -222,6 +176,58 @@ func resourceSecretRotationDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// rotateSecret enables rotation of the secret, either with the configured rotation Lambda function
+// or, if none is configured, with the managed rotation of the AWS service that owns the secret.
+func rotateSecret(conn *secretsmanager.SecretsManager, d *schema.ResourceData, secretID string) (*secretsmanager.RotateSecretOutput, error) {
+	input := &secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(d.Get("rotate_immediately").(bool)),
+		RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
+		SecretId:          aws.String(secretID),
+	}
+
+	if v, ok := d.GetOk("rotation_lambda_arn"); ok {
+		input.RotationLambdaARN = aws.String(v.(string))
+	} else {
+		secret, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(secretID),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading Secrets Manager Secret (%s): %w", secretID, err)
+		}
+
+		if aws.StringValue(secret.OwningService) == "" {
+			return nil, fmt.Errorf("rotation_lambda_arn is required: secret %q is not managed by an AWS service, so managed rotation is not available", secretID)
+		}
+	}
+
+	log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
+	var output *secretsmanager.RotateSecretOutput
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.RotateSecret(input)
+		if err != nil {
+			// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
+			if tfawserr.ErrMessageContains(err, "AccessDeniedException", "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.RotateSecret(input)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func expandSecretsManagerRotationRules(l []interface{}) *secretsmanager.RotationRulesType {
 	if len(l) == 0 {
 		return nil
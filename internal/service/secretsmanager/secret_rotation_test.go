@@ -2,6 +2,7 @@ package secretsmanager_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -52,9 +53,27 @@ func TestAccSecretsManagerSecretRotation_basic(t *testing.T) {
 			*/
 			// Test importing secret rotation
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_immediately"},
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretRotation_managedRotationUnavailable(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecretRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSecretRotationNoLambdaConfig(rName),
+				ExpectError: regexp.MustCompile(`rotation_lambda_arn is required`),
 			},
 		},
 	})
@@ -171,3 +190,20 @@ resource "aws_secretsmanager_secret_rotation" "test" {
 }
 `, rName, automaticallyAfterDays)
 }
+
+func testAccSecretRotationNoLambdaConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id          = aws_secretsmanager_secret.test.id
+  rotate_immediately = false
+
+  rotation_rules {
+    automatically_after_days = 7
+  }
+}
+`, rName)
+}
@@ -303,6 +303,69 @@ func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_jsonata(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sfn.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineJSONataConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "status", sfn.StateMachineStatusActive),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`"QueryLanguage": "JSONata"`)),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`\{% \$greeting %\}`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The same definition, encoded differently, must not produce a diff.
+				Config:   testAccStateMachineJSONataEncodedConfig(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_queryLanguage(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sfn.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineQueryLanguageConfig(rName, "JSONata"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`"QueryLanguage": "JSONata"`)),
+				),
+			},
+			{
+				Config: testAccStateMachineQueryLanguageConfig(rName, "JSONPath"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`"QueryLanguage": "JSONPath"`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -709,3 +772,93 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineJSONataConfig(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using JSONata and variables",
+  "QueryLanguage": "JSONata",
+  "StartAt": "SetGreeting",
+  "States": {
+    "SetGreeting": {
+      "Type": "Pass",
+      "Assign": {
+        "greeting": "{%% 'Hello, ' & $states.input.name %%}"
+      },
+      "Next": "HelloWorld"
+    },
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "Arguments": {
+        "message": "{%% $greeting %%}"
+      },
+      "Output": "{%% $states.result %%}",
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName))
+}
+
+func testAccStateMachineJSONataEncodedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = jsonencode({
+    Comment       = "A Hello World example of the Amazon States Language using JSONata and variables"
+    QueryLanguage = "JSONata"
+    StartAt       = "SetGreeting"
+    States = {
+      SetGreeting = {
+        Type = "Pass"
+        Assign = {
+          greeting = "{%% 'Hello, ' & $states.input.name %%}"
+        }
+        Next = "HelloWorld"
+      }
+      HelloWorld = {
+        Type     = "Task"
+        Resource = aws_lambda_function.test.arn
+        Arguments = {
+          message = "{%% $greeting %%}"
+        }
+        Output = "{%% $states.result %%}"
+        End    = true
+      }
+    }
+  })
+}
+`, rName))
+}
+
+func testAccStateMachineQueryLanguageConfig(rName, queryLanguage string) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "QueryLanguage": %[2]q,
+  "StartAt": "Done",
+  "States": {
+    "Done": {
+      "Type": "Pass",
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, queryLanguage))
+}
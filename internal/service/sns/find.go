@@ -57,3 +57,28 @@ func FindTopicAttributesByARN(conn *sns.SNS, arn string) (map[string]string, err
 
 	return aws.StringValueMap(output.Attributes), nil
 }
+
+func FindDataProtectionPolicyByARN(conn *sns.SNS, arn string) (string, error) {
+	input := &sns.GetDataProtectionPolicyInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDataProtectionPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException, sns.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || aws.StringValue(output.DataProtectionPolicy) == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.DataProtectionPolicy), nil
+}
@@ -0,0 +1,101 @@
+package sns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSNSTopicDataProtectionPolicy_basic(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic_data_protection_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDataProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicDataProtectionPolicyConfig(rName, `Audit = { SampleRate = "99", FindingsDestination = {} }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists("aws_sns_topic.test", &attributes),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_sns_topic.test", "arn"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(fmt.Sprintf(`"Name":"%[1]s"`, rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicDataProtectionPolicyConfig(rName, `Deny = {}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"Deny":`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTopicDataProtectionPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sns_topic_data_protection_policy" {
+			continue
+		}
+
+		_, err := tfsns.FindDataProtectionPolicyByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SNS Topic Data Protection Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTopicDataProtectionPolicyConfig(rName, operation string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_data_protection_policy" "test" {
+  arn = aws_sns_topic.test.arn
+  policy = jsonencode({
+    Name    = %[1]q
+    Version = "2021-06-01"
+    Statement = [{
+      Sid            = "%[1]s"
+      DataDirection  = "Inbound"
+      Principal      = ["*"]
+      DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/EmailAddress"]
+      Operation = {
+        %[2]s
+      }
+    }]
+  })
+}
+`, rName, operation)
+}
@@ -36,3 +36,11 @@ func FIFOThroughputLimit_Values() []string {
 		FIFOThroughputLimitPerQueue,
 	}
 }
+
+const (
+	MessageMoveTaskStatusCancelled  = "CANCELLED"
+	MessageMoveTaskStatusCancelling = "CANCELLING"
+	MessageMoveTaskStatusCompleted  = "COMPLETED"
+	MessageMoveTaskStatusFailed     = "FAILED"
+	MessageMoveTaskStatusRunning    = "RUNNING"
+)
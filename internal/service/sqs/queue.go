@@ -436,5 +436,20 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO queue")
 	}
 
+	if !diff.NewValueKnown("deduplication_scope") || !diff.NewValueKnown("fifo_throughput_limit") {
+		return nil
+	}
+
+	deduplicationScope := diff.Get("deduplication_scope").(string)
+	fifoThroughputLimit := diff.Get("fifo_throughput_limit").(string)
+
+	if !fifoQueue && (deduplicationScope != "" || fifoThroughputLimit != "") {
+		return fmt.Errorf("deduplication scope and FIFO throughput limit can only be set for FIFO queue")
+	}
+
+	if fifoThroughputLimit == FIFOThroughputLimitPerMessageGroupID && deduplicationScope != DeduplicationScopeMessageGroup {
+		return fmt.Errorf("FIFO throughput limit %s requires deduplication scope %s", FIFOThroughputLimitPerMessageGroupID, DeduplicationScopeMessageGroup)
+	}
+
 	return nil
 }
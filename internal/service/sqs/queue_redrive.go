@@ -0,0 +1,158 @@
+package sqs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceQueueRedrive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceQueueRedriveCreate,
+		Read:   resourceQueueRedriveRead,
+		Delete: resourceQueueRedriveDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"approximate_number_of_messages_moved": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"destination_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"max_number_of_messages_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_handle": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceQueueRedriveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	sourceARN := d.Get("source_arn").(string)
+	input := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(sourceARN),
+	}
+
+	if v, ok := d.GetOk("destination_arn"); ok {
+		input.DestinationArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_number_of_messages_per_second"); ok {
+		input.MaxNumberOfMessagesPerSecond = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Starting SQS Message Move Task: %s", input)
+	output, err := conn.StartMessageMoveTask(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting SQS Message Move Task (%s): %w", sourceARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.TaskHandle))
+
+	if _, err := waitMessageMoveTaskFinished(conn, sourceARN, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for SQS Message Move Task (%s) to finish: %w", d.Id(), err)
+	}
+
+	return resourceQueueRedriveRead(d, meta)
+}
+
+func resourceQueueRedriveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	output, err := FindMessageMoveTaskByTwoPartKey(conn, d.Get("source_arn").(string), d.Id())
+
+	// Only the most recent tasks are listed. A task that can no longer be found
+	// has finished, so keep the last known state instead of starting it again.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[DEBUG] SQS Message Move Task (%s) no longer listed, keeping last known state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Message Move Task (%s): %w", d.Id(), err)
+	}
+
+	d.Set("approximate_number_of_messages_moved", output.ApproximateNumberOfMessagesMoved)
+	d.Set("destination_arn", output.DestinationArn)
+	d.Set("max_number_of_messages_per_second", output.MaxNumberOfMessagesPerSecond)
+	d.Set("source_arn", output.SourceArn)
+	d.Set("status", output.Status)
+	d.Set("task_handle", output.TaskHandle)
+
+	return nil
+}
+
+func resourceQueueRedriveDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	sourceARN := d.Get("source_arn").(string)
+	output, err := FindMessageMoveTaskByTwoPartKey(conn, sourceARN, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Message Move Task (%s): %w", d.Id(), err)
+	}
+
+	if aws.StringValue(output.Status) != MessageMoveTaskStatusRunning {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling SQS Message Move Task: %s", d.Id())
+	_, err = conn.CancelMessageMoveTask(&sqs.CancelMessageMoveTaskInput{
+		TaskHandle: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling SQS Message Move Task (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitMessageMoveTaskFinished(conn, sourceARN, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for SQS Message Move Task (%s) to cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
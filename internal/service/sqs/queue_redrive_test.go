@@ -0,0 +1,59 @@
+package sqs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+)
+
+func TestAccSQSQueueRedrive_basic(t *testing.T) {
+	resourceName := "aws_sqs_queue_redrive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_sqs_queue.dlq", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "max_number_of_messages_per_second", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfsqs.MessageMoveTaskStatusCompleted),
+					resource.TestCheckResourceAttrSet(resourceName, "task_handle"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueueRedriveConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 1
+  })
+}
+
+resource "aws_sqs_queue_redrive" "test" {
+  source_arn                        = aws_sqs_queue.dlq.arn
+  destination_arn                   = aws_sqs_queue.test.arn
+  max_number_of_messages_per_second = 10
+}
+`, rName)
+}
@@ -619,6 +619,40 @@ func TestAccSQSQueue_FIFOQueue_highThroughputMode(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_FIFOQueue_expectHighThroughputModeError(t *testing.T) {
+	rName := fmt.Sprintf("%s.fifo", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFIFOQueueHighThroughputModeConfig(rName, "queue", "perMessageGroupId"),
+				ExpectError: regexp.MustCompile(`FIFO throughput limit perMessageGroupId requires deduplication scope messageGroup`),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_StandardQueue_expectHighThroughputModeError(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStandardQueueExpectHighThroughputModeErrorConfig(rName),
+				ExpectError: regexp.MustCompile(`deduplication scope and FIFO throughput limit can only be set for FIFO queue`),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_StandardQueue_expectContentBasedDeduplicationError(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -1115,6 +1149,16 @@ resource "aws_sqs_queue" "test" {
 `, rName, deduplicationScope, fifoThroughputLimit)
 }
 
+func testAccStandardQueueExpectHighThroughputModeErrorConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                  = %[1]q
+  deduplication_scope   = "messageGroup"
+  fifo_throughput_limit = "perMessageGroupId"
+}
+`, rName)
+}
+
 func testAccStandardQueueExpectContentBasedDeduplicationErrorConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
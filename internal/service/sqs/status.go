@@ -3,6 +3,7 @@ package sqs
 import (
 	"strconv"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -85,3 +86,19 @@ func statusQueueAttributeState(conn *sqs.SQS, url string, expected map[string]st
 		return got, status, nil
 	}
 }
+
+func statusMessageMoveTask(conn *sqs.SQS, sourceARN, taskHandle string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMessageMoveTaskByTwoPartKey(conn, sourceARN, taskHandle)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
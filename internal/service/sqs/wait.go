@@ -1,10 +1,13 @@
 package sqs
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -61,3 +64,25 @@ func waitQueueDeleted(conn *sqs.SQS, url string) error {
 
 	return err
 }
+
+func waitMessageMoveTaskFinished(conn *sqs.SQS, sourceARN, taskHandle string, timeout time.Duration) (*sqs.ListMessageMoveTasksResultEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{MessageMoveTaskStatusRunning, MessageMoveTaskStatusCancelling},
+		Target:  []string{MessageMoveTaskStatusCompleted, MessageMoveTaskStatusCancelled},
+		Refresh: statusMessageMoveTask(conn, sourceARN, taskHandle),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*sqs.ListMessageMoveTasksResultEntry); ok {
+		if status := aws.StringValue(output.Status); status == MessageMoveTaskStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindDocumentByName returns the Document corresponding to the specified name.
@@ -59,3 +62,73 @@ func FindPatchGroup(conn *ssm.SSM, patchGroup, baselineId string) (*ssm.PatchGro
 
 	return result, err
 }
+
+// FindParameterMetadataByName returns the metadata, including the tier, of the SSM Parameter with the specified name.
+func FindParameterMetadataByName(conn *ssm.SSM, name string) (*ssm.ParameterMetadata, error) {
+	input := &ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Name"),
+				Option: aws.String("Equals"),
+				Values: aws.StringSlice([]string{name}),
+			},
+		},
+	}
+
+	output, err := conn.DescribeParameters(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Parameters) == 0 || output.Parameters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Parameters[0], nil
+}
+
+// FindResourcePolicyByResourceARN returns the resource policy attached to the specified resource.
+// If policyID is empty the first policy found is returned.
+func FindResourcePolicyByResourceARN(conn *ssm.SSM, resourceARN, policyID string) (*ssm.GetResourcePoliciesResponseEntry, error) {
+	input := &ssm.GetResourcePoliciesInput{
+		ResourceArn: aws.String(resourceARN),
+	}
+	var result *ssm.GetResourcePoliciesResponseEntry
+
+	err := conn.GetResourcePoliciesPages(input, func(page *ssm.GetResourcePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Policies {
+			if v == nil {
+				continue
+			}
+
+			if policyID == "" || aws.StringValue(v.PolicyId) == policyID {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,208 @@
+package ssm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourcePolicyCreate,
+		Read:   resourceResourcePolicyRead,
+		Update: resourceResourcePolicyUpdate,
+		Delete: resourceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				ValidateFunc:     validation.StringIsJSON,
+			},
+			"policy_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceResourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	resourceARN := d.Get("resource_arn").(string)
+
+	if err := checkParameterTierForSharing(conn, resourceARN); err != nil {
+		return err
+	}
+
+	input := &ssm.PutResourcePolicyInput{
+		Policy:      aws.String(policy),
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	log.Printf("[DEBUG] Creating SSM Resource Policy: %s", input)
+	output, err := conn.PutResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSM Resource Policy (%s): %w", resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+	d.Set("policy_id", output.PolicyId)
+
+	return resourceResourcePolicyRead(d, meta)
+}
+
+func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	output, err := FindResourcePolicyByResourceARN(conn, d.Id(), d.Get("policy_id").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), aws.StringValue(output.Policy))
+
+	if err != nil {
+		return fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is an invalid JSON: %w", policyToSet, err)
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("policy_hash", output.PolicyHash)
+	d.Set("policy_id", output.PolicyId)
+	d.Set("resource_arn", d.Id())
+
+	return nil
+}
+
+func resourceResourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	input := &ssm.PutResourcePolicyInput{
+		Policy:      aws.String(policy),
+		PolicyHash:  aws.String(d.Get("policy_hash").(string)),
+		PolicyId:    aws.String(d.Get("policy_id").(string)),
+		ResourceArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating SSM Resource Policy: %s", input)
+	output, err := conn.PutResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating SSM Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_id", output.PolicyId)
+
+	return resourceResourcePolicyRead(d, meta)
+}
+
+func resourceResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	log.Printf("[DEBUG] Deleting SSM Resource Policy: %s", d.Id())
+	_, err := conn.DeleteResourcePolicy(&ssm.DeleteResourcePolicyInput{
+		PolicyHash:  aws.String(d.Get("policy_hash").(string)),
+		PolicyId:    aws.String(d.Get("policy_id").(string)),
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSM Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// checkParameterTierForSharing returns an error if the resource is an SSM Parameter
+// that is not in the Advanced tier, as only advanced parameters can be shared.
+func checkParameterTierForSharing(conn *ssm.SSM, resourceARN string) error {
+	parsedARN, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return fmt.Errorf("error parsing resource ARN (%s): %w", resourceARN, err)
+	}
+
+	name := strings.TrimPrefix(parsedARN.Resource, "parameter")
+
+	if name == parsedARN.Resource {
+		return nil
+	}
+
+	// The ARN of a parameter in a hierarchy includes its leading slash, e.g. parameter/a/b for /a/b.
+	if strings.Count(name, "/") == 1 {
+		name = strings.TrimPrefix(name, "/")
+	}
+
+	parameter, err := FindParameterMetadataByName(conn, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Parameter (%s): %w", name, err)
+	}
+
+	if tier := aws.StringValue(parameter.Tier); tier != ssm.ParameterTierAdvanced {
+		return fmt.Errorf("SSM Parameter (%s) uses the %s tier: only parameters in the %s tier can be shared", name, tier, ssm.ParameterTierAdvanced)
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package ssm_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMResourcePolicy_basic(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_resource_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig(rName, "ssm:GetParameter"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_ssm_parameter.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_hash"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourcePolicyConfig(rName, "ssm:GetParameters"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`ssm:GetParameters`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMResourcePolicy_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_resource_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig(rName, "ssm:GetParameter"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssm.ResourceResourcePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMResourcePolicy_standardTier(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePolicyStandardTierConfig(rName),
+				ExpectError: regexp.MustCompile(`only parameters in the Advanced tier can be shared`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourcePolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Resource Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+		_, err := tfssm.FindResourcePolicyByResourceARN(conn, rs.Primary.ID, rs.Primary.Attributes["policy_id"])
+
+		return err
+	}
+}
+
+func testAccCheckResourcePolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_resource_policy" {
+			continue
+		}
+
+		_, err := tfssm.FindResourcePolicyByResourceARN(conn, rs.Primary.ID, rs.Primary.Attributes["policy_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Resource Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccResourcePolicyBaseConfig(rName, tier string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = "test"
+  tier  = %[2]q
+}
+`, rName, tier))
+}
+
+func testAccResourcePolicyConfig(rName, action string) string {
+	return acctest.ConfigCompose(testAccResourcePolicyBaseConfig(rName, "Advanced"), fmt.Sprintf(`
+resource "aws_ssm_resource_policy" "test" {
+  resource_arn = aws_ssm_parameter.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.alternate.account_id}:root"
+      }
+      Action   = [%[1]q]
+      Resource = aws_ssm_parameter.test.arn
+    }]
+  })
+}
+`, action))
+}
+
+func testAccResourcePolicyStandardTierConfig(rName string) string {
+	return acctest.ConfigCompose(testAccResourcePolicyBaseConfig(rName, "Standard"), `
+resource "aws_ssm_resource_policy" "test" {
+  resource_arn = aws_ssm_parameter.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.alternate.account_id}:root"
+      }
+      Action   = ["ssm:GetParameter"]
+      Resource = aws_ssm_parameter.test.arn
+    }]
+  })
+}
+`)
+}
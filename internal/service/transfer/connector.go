@@ -0,0 +1,376 @@
+package transfer
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConnector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConnectorCreate,
+		Read:   resourceConnectorRead,
+		Update: resourceConnectorUpdate,
+		Delete: resourceConnectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"as2_config": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"as2_config", "sftp_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(transfer.CompressionEnum_Values(), false),
+						},
+						"encryption_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(transfer.EncryptionAlg_Values(), false),
+						},
+						"local_profile_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(19, 19),
+						},
+						"mdn_response": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(transfer.MdnResponse_Values(), false),
+						},
+						"mdn_signing_algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(transfer.MdnSigningAlg_Values(), false),
+						},
+						"message_subject": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"partner_profile_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(19, 19),
+						},
+						"signing_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(transfer.SigningAlg_Values(), false),
+						},
+					},
+				},
+			},
+			"connector_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logging_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sftp_config": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"as2_config", "sftp_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trusted_host_keys": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 2048),
+							},
+						},
+						"user_secret_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConnectorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &transfer.CreateConnectorInput{
+		AccessRole: aws.String(d.Get("access_role").(string)),
+		Url:        aws.String(d.Get("url").(string)),
+	}
+
+	if v, ok := d.GetOk("as2_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.As2Config = expandAs2ConnectorConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("logging_role"); ok {
+		input.LoggingRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sftp_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SftpConfig = expandSftpConnectorConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Transfer Connector: %s", input)
+	output, err := conn.CreateConnector(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Transfer Connector: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectorId))
+
+	return resourceConnectorRead(d, meta)
+}
+
+func resourceConnectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	connector, err := FindConnectorByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transfer Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Transfer Connector (%s): %w", d.Id(), err)
+	}
+
+	d.Set("access_role", connector.AccessRole)
+	d.Set("arn", connector.Arn)
+	if err := d.Set("as2_config", flattenAs2ConnectorConfig(connector.As2Config)); err != nil {
+		return fmt.Errorf("error setting as2_config: %w", err)
+	}
+	d.Set("connector_id", connector.ConnectorId)
+	d.Set("logging_role", connector.LoggingRole)
+	if err := d.Set("sftp_config", flattenSftpConnectorConfig(connector.SftpConfig)); err != nil {
+		return fmt.Errorf("error setting sftp_config: %w", err)
+	}
+	d.Set("url", connector.Url)
+
+	tags := KeyValueTags(connector.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceConnectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &transfer.UpdateConnectorInput{
+			ConnectorId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_role") {
+			input.AccessRole = aws.String(d.Get("access_role").(string))
+		}
+
+		if d.HasChange("as2_config") {
+			if v, ok := d.GetOk("as2_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.As2Config = expandAs2ConnectorConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("logging_role") {
+			input.LoggingRole = aws.String(d.Get("logging_role").(string))
+		}
+
+		if d.HasChange("sftp_config") {
+			if v, ok := d.GetOk("sftp_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SftpConfig = expandSftpConnectorConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("url") {
+			input.Url = aws.String(d.Get("url").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Transfer Connector: %s", input)
+		_, err := conn.UpdateConnector(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Transfer Connector (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %w", err)
+		}
+	}
+
+	return resourceConnectorRead(d, meta)
+}
+
+func resourceConnectorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn
+
+	log.Printf("[DEBUG] Deleting Transfer Connector: %s", d.Id())
+	_, err := conn.DeleteConnector(&transfer.DeleteConnectorInput{
+		ConnectorId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Transfer Connector (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAs2ConnectorConfig(tfMap map[string]interface{}) *transfer.As2ConnectorConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.As2ConnectorConfig{}
+
+	if v, ok := tfMap["compression"].(string); ok && v != "" {
+		apiObject.Compression = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_algorithm"].(string); ok && v != "" {
+		apiObject.EncryptionAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["local_profile_id"].(string); ok && v != "" {
+		apiObject.LocalProfileId = aws.String(v)
+	}
+
+	if v, ok := tfMap["mdn_response"].(string); ok && v != "" {
+		apiObject.MdnResponse = aws.String(v)
+	}
+
+	if v, ok := tfMap["mdn_signing_algorithm"].(string); ok && v != "" {
+		apiObject.MdnSigningAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["message_subject"].(string); ok && v != "" {
+		apiObject.MessageSubject = aws.String(v)
+	}
+
+	if v, ok := tfMap["partner_profile_id"].(string); ok && v != "" {
+		apiObject.PartnerProfileId = aws.String(v)
+	}
+
+	if v, ok := tfMap["signing_algorithm"].(string); ok && v != "" {
+		apiObject.SigningAlgorithm = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAs2ConnectorConfig(apiObject *transfer.As2ConnectorConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"compression":           aws.StringValue(apiObject.Compression),
+		"encryption_algorithm":  aws.StringValue(apiObject.EncryptionAlgorithm),
+		"local_profile_id":      aws.StringValue(apiObject.LocalProfileId),
+		"mdn_response":          aws.StringValue(apiObject.MdnResponse),
+		"mdn_signing_algorithm": aws.StringValue(apiObject.MdnSigningAlgorithm),
+		"message_subject":       aws.StringValue(apiObject.MessageSubject),
+		"partner_profile_id":    aws.StringValue(apiObject.PartnerProfileId),
+		"signing_algorithm":     aws.StringValue(apiObject.SigningAlgorithm),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSftpConnectorConfig(tfMap map[string]interface{}) *transfer.SftpConnectorConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.SftpConnectorConfig{}
+
+	if v, ok := tfMap["trusted_host_keys"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TrustedHostKeys = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["user_secret_id"].(string); ok && v != "" {
+		apiObject.UserSecretId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSftpConnectorConfig(apiObject *transfer.SftpConnectorConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"trusted_host_keys": aws.StringValueSlice(apiObject.TrustedHostKeys),
+		"user_secret_id":    aws.StringValue(apiObject.UserSecretId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,261 @@
+package transfer_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccConnector_sftp(t *testing.T) {
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	url := fmt.Sprintf("sftp://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorSFTPConfig(rName, url),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "access_role", "aws_iam_role.test", "arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "transfer", regexp.MustCompile(`connector/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "sftp_config.0.user_secret_id", "aws_secretsmanager_secret.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "url", url),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorSFTPConfig(rName, url+"/updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "url", url+"/updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnector_disappears(t *testing.T) {
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	url := fmt.Sprintf("sftp://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorSFTPConfig(rName, url),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tftransfer.ResourceConnector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConnector_tags(t *testing.T) {
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	url := fmt.Sprintf("sftp://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorTags1Config(rName, url, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorTags2Config(rName, url, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConnectorTags1Config(rName, url, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorExists(n string, v *transfer.DescribedConnector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer Connector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+
+		output, err := tftransfer.FindConnectorByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConnectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transfer_connector" {
+			continue
+		}
+
+		_, err := tftransfer.FindConnectorByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transfer Connector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConnectorBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "transfer.amazonaws.com"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+
+  secret_string = jsonencode({
+    Username = "testuser"
+    Password = "SuperSecretPassw0rd"
+  })
+}
+`, rName)
+}
+
+func testAccConnectorSFTPConfig(rName, url string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+  url         = %[1]q
+
+  sftp_config {
+    trusted_host_keys = ["ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDOtmSrIFqbDPdo4Hw6jg1J2YDUhNdTNbjT1KtYg3k/lrrDVE1QpGuFLb+aZPdoWLrqXr2PFLkyw6zEDKF7y5TVA+hb69h1GDDSNVf5bWBi/vF4lcxoa1qLz7N0JDUDpgU10nOmQQE+IA/BsYbD6UTdr/NT6L4PBwdzqlcjhu/vGJN+CnqQiBCHTvC+VfyIsi2fjsLfPJzv+fDiJRkF2Nad8OpGDaRPAyY/8w+ek1Xg2HE46g4/rQbj2PfIfCI8y3ZoKiwyqSiTHKeFAuOpCS+1ctqPMw6ZGKbCx4OFybjIP7Gh6vJD+I/NmPwPGqP8O3jTbvyvyWs1Ce/qKx/IJEg7a+LWXn8lRJIB40xeiGzEmlsZeLdY3zm8Un/P8ArkPf+e5RPqGQbPIevbvSAi/E7l4n7kkiMbhNA+AWUV4uSUxGoeHpjPbSr5fUuyoSQC+6J4JdjHsMr0u1tx4yJBBGA7GASUjNPZ5bcyQ7QVrz0rR4eaUV2cY5Kh8hwP7vAFUfE= example"]
+    user_secret_id    = aws_secretsmanager_secret.test.id
+  }
+}
+`, url))
+}
+
+func testAccConnectorTags1Config(rName, url, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+  url         = %[1]q
+
+  sftp_config {
+    user_secret_id = aws_secretsmanager_secret.test.id
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, url, tagKey1, tagValue1))
+}
+
+func testAccConnectorTags2Config(rName, url, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+  url         = %[1]q
+
+  sftp_config {
+    user_secret_id = aws_secretsmanager_secret.test.id
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, url, tagKey1, tagValue1, tagKey2, tagValue2))
+}
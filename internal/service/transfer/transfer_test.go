@@ -12,6 +12,11 @@ func TestAccTransfer_serial(t *testing.T) {
 			"S3Basic":    testAccAccess_s3_basic,
 			"S3Policy":   testAccAccess_s3_policy,
 		},
+		"Connector": {
+			"disappears": testAccConnector_disappears,
+			"SFTP":       testAccConnector_sftp,
+			"Tags":       testAccConnector_tags,
+		},
 		"Server": {
 			"basic":                         testAccServer_basic,
 			"disappears":                    testAccServer_disappears,
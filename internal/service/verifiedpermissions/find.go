@@ -0,0 +1,112 @@
+package verifiedpermissions
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindPolicyStoreByID(conn *verifiedpermissions.VerifiedPermissions, id string) (*verifiedpermissions.GetPolicyStoreOutput, error) {
+	input := &verifiedpermissions.GetPolicyStoreInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetPolicyStore(input)
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSchemaByPolicyStoreID(conn *verifiedpermissions.VerifiedPermissions, id string) (*verifiedpermissions.GetSchemaOutput, error) {
+	input := &verifiedpermissions.GetSchemaInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetSchema(input)
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Removing a schema leaves an empty one behind in the policy store.
+	if output == nil || aws.StringValue(output.Schema) == "" || aws.StringValue(output.Schema) == "{}" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindPolicyTemplateByTwoPartKey(conn *verifiedpermissions.VerifiedPermissions, policyStoreID, policyTemplateID string) (*verifiedpermissions.GetPolicyTemplateOutput, error) {
+	input := &verifiedpermissions.GetPolicyTemplateInput{
+		PolicyStoreId:    aws.String(policyStoreID),
+		PolicyTemplateId: aws.String(policyTemplateID),
+	}
+
+	output, err := conn.GetPolicyTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindPolicyByTwoPartKey(conn *verifiedpermissions.VerifiedPermissions, policyStoreID, policyID string) (*verifiedpermissions.GetPolicyOutput, error) {
+	input := &verifiedpermissions.GetPolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
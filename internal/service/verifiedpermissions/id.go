@@ -0,0 +1,42 @@
+package verifiedpermissions
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = ","
+
+func PolicyCreateResourceID(policyID, policyStoreID string) string {
+	parts := []string{policyID, policyStoreID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func PolicyParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected POLICY_ID%[2]sPOLICY_STORE_ID", id, resourceIDSeparator)
+}
+
+func PolicyTemplateCreateResourceID(policyTemplateID, policyStoreID string) string {
+	parts := []string{policyTemplateID, policyStoreID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func PolicyTemplateParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected POLICY_TEMPLATE_ID%[2]sPOLICY_STORE_ID", id, resourceIDSeparator)
+}
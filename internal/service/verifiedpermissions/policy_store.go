@@ -0,0 +1,167 @@
+package verifiedpermissions
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePolicyStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePolicyStoreCreate,
+		Read:   resourcePolicyStoreRead,
+		Update: resourcePolicyStoreUpdate,
+		Delete: resourcePolicyStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validation_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(verifiedpermissions.ValidationMode_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePolicyStoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	input := &verifiedpermissions.CreatePolicyStoreInput{
+		ValidationSettings: expandValidationSettings(d.Get("validation_settings").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Verified Permissions Policy Store: %s", input)
+	output, err := conn.CreatePolicyStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Verified Permissions Policy Store: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.PolicyStoreId))
+
+	return resourcePolicyStoreRead(d, meta)
+}
+
+func resourcePolicyStoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	output, err := FindPolicyStoreByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Policy Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Verified Permissions Policy Store (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("policy_store_id", output.PolicyStoreId)
+	if err := d.Set("validation_settings", flattenValidationSettings(output.ValidationSettings)); err != nil {
+		return fmt.Errorf("error setting validation_settings: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePolicyStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	input := &verifiedpermissions.UpdatePolicyStoreInput{
+		PolicyStoreId:      aws.String(d.Id()),
+		ValidationSettings: expandValidationSettings(d.Get("validation_settings").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Verified Permissions Policy Store: %s", input)
+	_, err := conn.UpdatePolicyStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Verified Permissions Policy Store (%s): %w", d.Id(), err)
+	}
+
+	return resourcePolicyStoreRead(d, meta)
+}
+
+func resourcePolicyStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Policy Store: %s", d.Id())
+	_, err := conn.DeletePolicyStore(&verifiedpermissions.DeletePolicyStoreInput{
+		PolicyStoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Verified Permissions Policy Store (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandValidationSettings(tfList []interface{}) *verifiedpermissions.ValidationSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &verifiedpermissions.ValidationSettings{
+		Mode: aws.String(tfMap["mode"].(string)),
+	}
+}
+
+func flattenValidationSettings(apiObject *verifiedpermissions.ValidationSettings) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"mode": aws.StringValue(apiObject.Mode),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,134 @@
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedPermissionsPolicyStore_basic(t *testing.T) {
+	var v verifiedpermissions.GetPolicyStoreOutput
+	resourceName := "aws_verifiedpermissions_policy_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(verifiedpermissions.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStoreConfig("OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStoreExists(resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "verifiedpermissions", regexp.MustCompile(`policy-store/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "validation_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validation_settings.0.mode", "OFF"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyStoreConfig("STRICT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "validation_settings.0.mode", "STRICT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyStore_disappears(t *testing.T) {
+	var v verifiedpermissions.GetPolicyStoreOutput
+	resourceName := "aws_verifiedpermissions_policy_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(verifiedpermissions.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStoreConfig("OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStoreExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfverifiedpermissions.ResourcePolicyStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyStoreExists(n string, v *verifiedpermissions.GetPolicyStoreOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Permissions Policy Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+		output, err := tfverifiedpermissions.FindPolicyStoreByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedpermissions_policy_store" {
+			continue
+		}
+
+		_, err := tfverifiedpermissions.FindPolicyStoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Permissions Policy Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPolicyStoreConfig(mode string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = %[1]q
+  }
+}
+`, mode)
+}
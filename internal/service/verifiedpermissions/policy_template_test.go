@@ -0,0 +1,128 @@
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedPermissionsPolicyTemplate_basic(t *testing.T) {
+	var v verifiedpermissions.GetPolicyTemplateOutput
+	resourceName := "aws_verifiedpermissions_policy_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(verifiedpermissions.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateConfig("view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "statement", `permit (principal == ?principal, action == Action::"view", resource == ?resource);`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyTemplateConfig("edit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "statement", `permit (principal == ?principal, action == Action::"edit", resource == ?resource);`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyTemplateExists(n string, v *verifiedpermissions.GetPolicyTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Permissions Policy Template ID is set")
+		}
+
+		policyTemplateID, policyStoreID, err := tfverifiedpermissions.PolicyTemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+		output, err := tfverifiedpermissions.FindPolicyTemplateByTwoPartKey(conn, policyStoreID, policyTemplateID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedpermissions_policy_template" {
+			continue
+		}
+
+		policyTemplateID, policyStoreID, err := tfverifiedpermissions.PolicyTemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfverifiedpermissions.FindPolicyTemplateByTwoPartKey(conn, policyStoreID, policyTemplateID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Permissions Policy Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPolicyTemplateConfig(action string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  description     = "test"
+  statement       = "permit (principal == ?principal, action == Action::\"%[1]s\", resource == ?resource);"
+}
+`, action)
+}
@@ -0,0 +1,234 @@
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedPermissionsPolicy_static(t *testing.T) {
+	var v verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(verifiedpermissions.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStaticConfig("view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", `permit (principal, action == Action::"view", resource);`),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", verifiedpermissions.PolicyTypeStatic),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyStaticConfig("edit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", `permit (principal, action == Action::"edit", resource);`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_templateLinked(t *testing.T) {
+	var v verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(verifiedpermissions.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateLinkedConfig("alice"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.template_linked.0.policy_template_id", "aws_verifiedpermissions_policy_template.test", "policy_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_id", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_type", "User"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.0.entity_id", "vacation.jpg"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.0.entity_type", "Photo"),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", verifiedpermissions.PolicyTypeTemplateLinked),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyTemplateLinkedConfig("bob"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_id", "bob"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
+	var v verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(verifiedpermissions.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStaticConfig("view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfverifiedpermissions.ResourcePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyExists(n string, v *verifiedpermissions.GetPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Permissions Policy ID is set")
+		}
+
+		policyID, policyStoreID, err := tfverifiedpermissions.PolicyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+		output, err := tfverifiedpermissions.FindPolicyByTwoPartKey(conn, policyStoreID, policyID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedpermissions_policy" {
+			continue
+		}
+
+		policyID, policyStoreID, err := tfverifiedpermissions.PolicyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfverifiedpermissions.FindPolicyByTwoPartKey(conn, policyStoreID, policyID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Permissions Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPolicyBaseConfig() string {
+	return `
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+`
+}
+
+func testAccPolicyStaticConfig(action string) string {
+	return acctest.ConfigCompose(testAccPolicyBaseConfig(), fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      description = "test"
+      statement   = "permit (principal, action == Action::\"%[1]s\", resource);"
+    }
+  }
+}
+`, action))
+}
+
+func testAccPolicyTemplateLinkedConfig(principalID string) string {
+	return acctest.ConfigCompose(testAccPolicyBaseConfig(), fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  statement       = "permit (principal == ?principal, action == Action::\"view\", resource == ?resource);"
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    template_linked {
+      policy_template_id = aws_verifiedpermissions_policy_template.test.policy_template_id
+
+      principal {
+        entity_id   = %[1]q
+        entity_type = "User"
+      }
+
+      resource {
+        entity_id   = "vacation.jpg"
+        entity_type = "Photo"
+      }
+    }
+  }
+}
+`, principalID))
+}
@@ -0,0 +1,142 @@
+package verifiedpermissions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// emptySchema is the Cedar JSON schema that leaves a policy store without any entity types or actions.
+// The API has no operation to remove a schema, so deleting the resource writes this instead.
+const emptySchema = "{}"
+
+func ResourceSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSchemaPut,
+		Read:   resourceSchemaRead,
+		Update: resourceSchemaPut,
+		Delete: resourceSchemaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSchemaPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	tfMap := d.Get("definition").([]interface{})[0].(map[string]interface{})
+
+	cedarJSON, err := structure.NormalizeJsonString(tfMap["value"].(string))
+
+	if err != nil {
+		return fmt.Errorf("definition (%s) is invalid JSON: %w", tfMap["value"].(string), err)
+	}
+
+	input := &verifiedpermissions.PutSchemaInput{
+		Definition: &verifiedpermissions.SchemaDefinition{
+			CedarJson: aws.String(cedarJSON),
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	log.Printf("[DEBUG] Putting Verified Permissions Schema: %s", policyStoreID)
+	_, err = conn.PutSchema(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Verified Permissions Schema (%s): %w", policyStoreID, err)
+	}
+
+	d.SetId(policyStoreID)
+
+	return resourceSchemaRead(d, meta)
+}
+
+func resourceSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	output, err := FindSchemaByPolicyStoreID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Schema (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Verified Permissions Schema (%s): %w", d.Id(), err)
+	}
+
+	cedarJSON, err := structure.NormalizeJsonString(aws.StringValue(output.Schema))
+
+	if err != nil {
+		return fmt.Errorf("schema (%s) returned by the API is invalid JSON: %w", d.Id(), err)
+	}
+
+	if err := d.Set("definition", []interface{}{map[string]interface{}{"value": cedarJSON}}); err != nil {
+		return fmt.Errorf("error setting definition: %w", err)
+	}
+	d.Set("policy_store_id", output.PolicyStoreId)
+
+	return nil
+}
+
+func resourceSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Schema: %s", d.Id())
+	_, err := conn.PutSchema(&verifiedpermissions.PutSchemaInput{
+		Definition: &verifiedpermissions.SchemaDefinition{
+			CedarJson: aws.String(emptySchema),
+		},
+		PolicyStoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Verified Permissions Schema (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedPermissionsSchema_basic(t *testing.T) {
+	var v verifiedpermissions.GetSchemaOutput
+	resourceName := "aws_verifiedpermissions_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(verifiedpermissions.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig("User"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.value", `{"PhotoApp":{"actions":{"viewPhoto":{"appliesTo":{"principalTypes":["User"],"resourceTypes":["Photo"]}}},"entityTypes":{"Photo":{},"User":{}}}}`),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccSchemaReformattedConfig("User"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccSchemaConfig("Employee"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.value", `{"PhotoApp":{"actions":{"viewPhoto":{"appliesTo":{"principalTypes":["Employee"],"resourceTypes":["Photo"]}}},"entityTypes":{"Employee":{},"Photo":{}}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsSchema_disappears(t *testing.T) {
+	var v verifiedpermissions.GetSchemaOutput
+	resourceName := "aws_verifiedpermissions_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(verifiedpermissions.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig("User"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfverifiedpermissions.ResourceSchema(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaExists(n string, v *verifiedpermissions.GetSchemaOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Permissions Schema ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+		output, err := tfverifiedpermissions.FindSchemaByPolicyStoreID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSchemaDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedpermissions_schema" {
+			continue
+		}
+
+		_, err := tfverifiedpermissions.FindSchemaByPolicyStoreID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Permissions Schema %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSchemaConfig(principalType string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    value = jsonencode({
+      PhotoApp = {
+        entityTypes = {
+          %[1]s = {}
+          Photo = {}
+        }
+        actions = {
+          viewPhoto = {
+            appliesTo = {
+              principalTypes = [%[1]q]
+              resourceTypes  = ["Photo"]
+            }
+          }
+        }
+      }
+    })
+  }
+}
+`, principalType)
+}
+
+// testAccSchemaReformattedConfig declares the same schema as testAccSchemaConfig with different key order and whitespace.
+func testAccSchemaReformattedConfig(principalType string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    value = <<EOF
+{
+  "PhotoApp": {
+    "entityTypes": { "Photo": {}, "%[1]s": {} },
+    "actions": {
+      "viewPhoto": {
+        "appliesTo": { "resourceTypes": ["Photo"], "principalTypes": ["%[1]s"] }
+      }
+    }
+  }
+}
+EOF
+  }
+}
+`, principalType)
+}
@@ -80,6 +80,10 @@ func expandRuleAction(l []interface{}) *wafv2.RuleAction {
 		action.Block = expandBlockAction(v.([]interface{}))
 	}
 
+	if v, ok := m["challenge"]; ok && len(v.([]interface{})) > 0 {
+		action.Challenge = expandChallengeAction(v.([]interface{}))
+	}
+
 	if v, ok := m["count"]; ok && len(v.([]interface{})) > 0 {
 		action.Count = expandCountAction(v.([]interface{}))
 	}
@@ -106,6 +110,25 @@ func expandAllowAction(l []interface{}) *wafv2.AllowAction {
 	return action
 }
 
+func expandChallengeAction(l []interface{}) *wafv2.ChallengeAction {
+	action := &wafv2.ChallengeAction{}
+
+	if len(l) == 0 || l[0] == nil {
+		return action
+	}
+
+	m, ok := l[0].(map[string]interface{})
+	if !ok {
+		return action
+	}
+
+	if v, ok := m["custom_request_handling"].([]interface{}); ok && len(v) > 0 {
+		action.CustomRequestHandling = expandCustomRequestHandling(v)
+	}
+
+	return action
+}
+
 func expandCountAction(l []interface{}) *wafv2.CountAction {
 	action := &wafv2.CountAction{}
 
@@ -642,6 +665,10 @@ func flattenRuleAction(a *wafv2.RuleAction) interface{} {
 		m["block"] = flattenBlock(a.Block)
 	}
 
+	if a.Challenge != nil {
+		m["challenge"] = flattenChallenge(a.Challenge)
+	}
+
 	if a.Count != nil {
 		m["count"] = flattenCount(a.Count)
 	}
@@ -676,6 +703,19 @@ func flattenBlock(a *wafv2.BlockAction) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenChallenge(a *wafv2.ChallengeAction) []interface{} {
+	if a == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{}
+
+	if a.CustomRequestHandling != nil {
+		m["custom_request_handling"] = flattenCustomRequestHandling(a.CustomRequestHandling)
+	}
+
+	return []interface{}{m}
+}
+
 func flattenCount(a *wafv2.CountAction) []interface{} {
 	if a == nil {
 		return []interface{}{}
@@ -91,9 +91,10 @@ func ResourceRuleGroup() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"allow": allowConfigSchema(),
-									"block": blockConfigSchema(),
-									"count": countConfigSchema(),
+									"allow":     allowConfigSchema(),
+									"block":     blockConfigSchema(),
+									"challenge": challengeConfigSchema(),
+									"count":     countConfigSchema(),
 								},
 							},
 						},
@@ -470,6 +470,19 @@ func allowConfigSchema() *schema.Schema {
 	}
 }
 
+func challengeConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"custom_request_handling": customRequestHandlingSchema(),
+			},
+		},
+	}
+}
+
 func countConfigSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -604,3 +617,30 @@ func customResponseBodySchema() *schema.Schema {
 		},
 	}
 }
+
+// dataSourceSchemaFromResourceSchema returns a copy of the given resource
+// attribute schema with all attributes, including nested ones, computed.
+func dataSourceSchemaFromResourceSchema(v *schema.Schema) *schema.Schema {
+	dsSchema := &schema.Schema{
+		Type:        v.Type,
+		Computed:    true,
+		Description: v.Description,
+		Sensitive:   v.Sensitive,
+		Set:         v.Set,
+	}
+
+	switch elem := v.Elem.(type) {
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+
+		for k, v := range elem.Schema {
+			nested[k] = dataSourceSchemaFromResourceSchema(v)
+		}
+
+		dsSchema.Elem = &schema.Resource{Schema: nested}
+	case *schema.Schema:
+		dsSchema.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return dsSchema
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -56,6 +57,30 @@ func ResourceWebACL() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"challenge_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"immunity_time_property": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"immunity_time": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      300,
+										ValidateFunc: validation.IntBetween(300, 259200),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"custom_response_body": customResponseBodySchema(),
 			"default_action": {
 				Type:     schema.TypeList,
@@ -106,9 +131,10 @@ func ResourceWebACL() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"allow": allowConfigSchema(),
-									"block": blockConfigSchema(),
-									"count": countConfigSchema(),
+									"allow":     allowConfigSchema(),
+									"block":     blockConfigSchema(),
+									"challenge": challengeConfigSchema(),
+									"count":     countConfigSchema(),
 								},
 							},
 						},
@@ -138,8 +164,16 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
-			"tags":              tftags.TagsSchema(),
-			"tags_all":          tftags.TagsSchemaComputed(),
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"token_domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 253),
+				},
+			},
 			"visibility_config": visibilityConfigSchema(),
 		},
 
@@ -161,10 +195,18 @@ func resourceWebACLCreate(d *schema.ResourceData, meta interface{}) error {
 		VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("challenge_config"); ok {
+		params.ChallengeConfig = expandChallengeConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
 		params.CustomResponseBodies = expandCustomResponseBodies(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("token_domains"); ok && v.(*schema.Set).Len() > 0 {
+		params.TokenDomains = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		params.Description = aws.String(v.(string))
 	}
@@ -233,6 +275,10 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", resp.WebACL.ARN)
 	d.Set("lock_token", resp.LockToken)
 
+	if err := d.Set("challenge_config", flattenChallengeConfig(resp.WebACL.ChallengeConfig)); err != nil {
+		return fmt.Errorf("Error setting challenge_config: %w", err)
+	}
+
 	if err := d.Set("custom_response_body", flattenCustomResponseBodies(resp.WebACL.CustomResponseBodies)); err != nil {
 		return fmt.Errorf("Error setting custom_response_body: %w", err)
 	}
@@ -245,6 +291,10 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting rule: %w", err)
 	}
 
+	if err := d.Set("token_domains", aws.StringValueSlice(resp.WebACL.TokenDomains)); err != nil {
+		return fmt.Errorf("Error setting token_domains: %w", err)
+	}
+
 	if err := d.Set("visibility_config", flattenVisibilityConfig(resp.WebACL.VisibilityConfig)); err != nil {
 		return fmt.Errorf("Error setting visibility_config: %w", err)
 	}
@@ -272,7 +322,7 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 func resourceWebACLUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WAFV2Conn
 
-	if d.HasChanges("custom_response_body", "default_action", "description", "rule", "visibility_config") {
+	if d.HasChanges("challenge_config", "custom_response_body", "default_action", "description", "rule", "token_domains", "visibility_config") {
 		u := &wafv2.UpdateWebACLInput{
 			Id:               aws.String(d.Id()),
 			Name:             aws.String(d.Get("name").(string)),
@@ -283,10 +333,18 @@ func resourceWebACLUpdate(d *schema.ResourceData, meta interface{}) error {
 			VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 		}
 
+		if v, ok := d.GetOk("challenge_config"); ok {
+			u.ChallengeConfig = expandChallengeConfig(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
 			u.CustomResponseBodies = expandCustomResponseBodies(v.(*schema.Set).List())
 		}
 
+		if v, ok := d.GetOk("token_domains"); ok && v.(*schema.Set).Len() > 0 {
+			u.TokenDomains = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
 		if v, ok := d.GetOk("description"); ok {
 			u.Description = aws.String(v.(string))
 		}
@@ -574,6 +632,23 @@ func expandDefaultAction(l []interface{}) *wafv2.DefaultAction {
 	return action
 }
 
+func expandChallengeConfig(l []interface{}) *wafv2.ChallengeConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &wafv2.ChallengeConfig{}
+
+	if v, ok := m["immunity_time_property"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.ImmunityTimeProperty = &wafv2.ImmunityTimeProperty{
+			ImmunityTime: aws.Int64(int64(v[0].(map[string]interface{})["immunity_time"].(int))),
+		}
+	}
+
+	return config
+}
+
 func expandWebACLRootStatement(l []interface{}) *wafv2.Statement {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -859,6 +934,24 @@ func flattenDefaultAction(a *wafv2.DefaultAction) interface{} {
 	return []interface{}{m}
 }
 
+func flattenChallengeConfig(c *wafv2.ChallengeConfig) interface{} {
+	if c == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if c.ImmunityTimeProperty != nil {
+		m["immunity_time_property"] = []interface{}{
+			map[string]interface{}{
+				"immunity_time": int(aws.Int64Value(c.ImmunityTimeProperty.ImmunityTime)),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
 func flattenManagedRuleGroupStatement(apiObject *wafv2.ManagedRuleGroupStatement) interface{} {
 	if apiObject == nil {
 		return []interface{}{}
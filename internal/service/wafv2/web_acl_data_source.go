@@ -11,6 +11,8 @@ import (
 )
 
 func DataSourceWebACL() *schema.Resource {
+	webACLSchema := ResourceWebACL().Schema
+
 	return &schema.Resource{
 		Read: dataSourceWebACLRead,
 
@@ -19,6 +21,11 @@ func DataSourceWebACL() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"default_action": dataSourceSchemaFromResourceSchema(webACLSchema["default_action"]),
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -27,6 +34,7 @@ func DataSourceWebACL() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"rule": dataSourceSchemaFromResourceSchema(webACLSchema["rule"]),
 			"scope": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +43,7 @@ func DataSourceWebACL() *schema.Resource {
 					wafv2.ScopeRegional,
 				}, false),
 			},
+			"visibility_config": dataSourceSchemaFromResourceSchema(webACLSchema["visibility_config"]),
 		},
 	}
 }
@@ -42,43 +51,69 @@ func DataSourceWebACL() *schema.Resource {
 func dataSourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WAFV2Conn
 	name := d.Get("name").(string)
+	scope := d.Get("scope").(string)
 
 	var foundWebACL *wafv2.WebACLSummary
 	input := &wafv2.ListWebACLsInput{
-		Scope: aws.String(d.Get("scope").(string)),
+		Scope: aws.String(scope),
 		Limit: aws.Int64(100),
 	}
 
-	for {
-		resp, err := conn.ListWebACLs(input)
-		if err != nil {
-			return fmt.Errorf("Error reading WAFv2 WebACLs: %w", err)
-		}
-
-		if resp == nil || resp.WebACLs == nil {
-			return fmt.Errorf("Error reading WAFv2 WebACLs")
+	err := listWebACLsPages(conn, input, func(page *wafv2.ListWebACLsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, webACL := range resp.WebACLs {
+		for _, webACL := range page.WebACLs {
 			if aws.StringValue(webACL.Name) == name {
 				foundWebACL = webACL
-				break
+				return false
 			}
 		}
 
-		if resp.NextMarker == nil || foundWebACL != nil {
-			break
-		}
-		input.NextMarker = resp.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading WAFv2 WebACLs: %w", err)
 	}
 
 	if foundWebACL == nil {
 		return fmt.Errorf("WAFv2 WebACL not found for name: %s", name)
 	}
 
-	d.SetId(aws.StringValue(foundWebACL.Id))
-	d.Set("arn", foundWebACL.ARN)
-	d.Set("description", foundWebACL.Description)
+	resp, err := conn.GetWebACL(&wafv2.GetWebACLInput{
+		Id:    foundWebACL.Id,
+		Name:  aws.String(name),
+		Scope: aws.String(scope),
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading WAFv2 WebACL (%s): %w", aws.StringValue(foundWebACL.Id), err)
+	}
+
+	if resp == nil || resp.WebACL == nil {
+		return fmt.Errorf("Error reading WAFv2 WebACL (%s): empty output", aws.StringValue(foundWebACL.Id))
+	}
+
+	webACL := resp.WebACL
+
+	d.SetId(aws.StringValue(webACL.Id))
+	d.Set("arn", webACL.ARN)
+	d.Set("capacity", webACL.Capacity)
+	d.Set("description", webACL.Description)
+
+	if err := d.Set("default_action", flattenDefaultAction(webACL.DefaultAction)); err != nil {
+		return fmt.Errorf("Error setting default_action: %w", err)
+	}
+
+	if err := d.Set("rule", flattenWebACLRules(webACL.Rules)); err != nil {
+		return fmt.Errorf("Error setting rule: %w", err)
+	}
+
+	if err := d.Set("visibility_config", flattenVisibilityConfig(webACL.VisibilityConfig)); err != nil {
+		return fmt.Errorf("Error setting visibility_config: %w", err)
+	}
 
 	return nil
 }
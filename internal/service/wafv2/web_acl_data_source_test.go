@@ -30,10 +30,23 @@ func TestAccWAFV2WebACLDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
 					acctest.MatchResourceAttrRegionalARN(datasourceName, "arn", "wafv2", regexp.MustCompile(fmt.Sprintf("regional/webacl/%v/.+$", name))),
+					resource.TestCheckResourceAttrPair(datasourceName, "capacity", resourceName, "capacity"),
+					resource.TestCheckResourceAttrPair(datasourceName, "default_action.#", resourceName, "default_action.#"),
+					resource.TestCheckResourceAttr(datasourceName, "default_action.0.block.#", "1"),
 					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
 					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
 					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "rule.#", resourceName, "rule.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "rule.*", map[string]string{
+						"name":                              "rule-1",
+						"priority":                          "1",
+						"action.#":                          "1",
+						"action.0.count.#":                  "1",
+						"statement.#":                       "1",
+						"statement.0.geo_match_statement.#": "1",
+					}),
 					resource.TestCheckResourceAttrPair(datasourceName, "scope", resourceName, "scope"),
+					resource.TestCheckResourceAttrPair(datasourceName, "visibility_config.0.metric_name", resourceName, "visibility_config.0.metric_name"),
 				),
 			},
 		},
@@ -50,9 +63,30 @@ resource "aws_wafv2_web_acl" "test" {
     block {}
   }
 
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    action {
+      count {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["US", "NL"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+
   visibility_config {
     cloudwatch_metrics_enabled = false
-    metric_name                = "friendly-rule-metric-name"
+    metric_name                = "friendly-metric-name"
     sampled_requests_enabled   = false
   }
 }
@@ -1382,6 +1382,55 @@ func TestAccWAFV2WebACL_Custom_response(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACL_challenge(t *testing.T) {
+	var v wafv2.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_Challenge(webACLName, 300, "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "challenge_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "challenge_config.0.immunity_time_property.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "challenge_config.0.immunity_time_property.0.immunity_time", "300"),
+					resource.TestCheckResourceAttr(resourceName, "token_domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "token_domains.*", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"action.#":             "1",
+						"action.0.allow.#":     "0",
+						"action.0.block.#":     "0",
+						"action.0.challenge.#": "1",
+						"action.0.count.#":     "0",
+					}),
+				),
+			},
+			{
+				Config: testAccWebACLConfig_Challenge(webACLName, 600, "example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "challenge_config.0.immunity_time_property.0.immunity_time", "600"),
+					resource.TestCheckResourceAttr(resourceName, "token_domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "token_domains.*", "example.org"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWebACLImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func TestAccWAFV2WebACL_tags(t *testing.T) {
 	var v wafv2.WebACL
 	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1867,6 +1916,49 @@ resource "aws_wafv2_web_acl" "test" {
 `, name)
 }
 
+func testAccWebACLConfig_Challenge(name string, immunityTime int, tokenDomain string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name          = "%[1]s"
+  description   = "%[1]s"
+  scope         = "REGIONAL"
+  token_domains = [%[3]q]
+
+  challenge_config {
+    immunity_time_property {
+      immunity_time = %[2]d
+    }
+  }
+
+  default_action {
+    allow {}
+  }
+  rule {
+    name     = "rule-1"
+    priority = 1
+    action {
+      challenge {}
+    }
+    statement {
+      geo_match_statement {
+        country_codes = ["US", "CA"]
+      }
+    }
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, immunityTime, tokenDomain)
+}
+
 func testAccWebACLConfig_NoRuleLabels(name string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
@@ -21,7 +21,8 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags                 KeyValueTags
+	ExcludeResourceTypes map[string]struct{}
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -58,6 +59,20 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResourceType returns the DefaultConfig to use for the given resource type,
+// or nil if default tags are excluded from that resource type.
+func (dc *DefaultConfig) ForResourceType(resourceType string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	if _, ok := dc.ExcludeResourceTypes[resourceType]; ok {
+		return nil
+	}
+
+	return dc
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
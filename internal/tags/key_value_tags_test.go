@@ -43,6 +43,63 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	defaultConfig := &DefaultConfig{
+		Tags: New(map[string]string{
+			"key1": "value1",
+		}),
+		ExcludeResourceTypes: map[string]struct{}{
+			"aws_autoscaling_group": {},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceType  string
+		want          *DefaultConfig
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			resourceType:  "aws_instance",
+			want:          nil,
+		},
+		{
+			name:          "no exclusions",
+			defaultConfig: &DefaultConfig{},
+			resourceType:  "aws_instance",
+			want:          &DefaultConfig{},
+		},
+		{
+			name:          "resource type not excluded",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_instance",
+			want:          defaultConfig,
+		},
+		{
+			name:          "resource type excluded",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_autoscaling_group",
+			want:          nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.ForResourceType(testCase.resourceType)
+
+			if (got == nil) != (testCase.want == nil) {
+				t.Fatalf("got %v, want %v", got, testCase.want)
+			}
+
+			if got != nil {
+				testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want.Tags.Map())
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	testCases := []struct {
 		name          string